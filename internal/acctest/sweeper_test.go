@@ -15,3 +15,18 @@ func TestMain(m *testing.M) {
 func TestIsTestResource(t *testing.T) {
 	assert.True(t, acctest.IsTestResource("tf_tests_mnq_sqs_queue_default_project"))
 }
+
+func TestShouldSweep(t *testing.T) {
+	assert.True(t, acctest.ShouldSweep("scaleway_vpc", "id", "tf-tests-vpc", nil))
+
+	t.Setenv(acctest.SweepNamePrefixEnv, "tf-tests")
+	assert.True(t, acctest.ShouldSweep("scaleway_vpc", "id", "tf-tests-vpc", nil))
+	assert.False(t, acctest.ShouldSweep("scaleway_vpc", "id", "production", nil))
+
+	t.Setenv(acctest.SweepTagEnv, "ci")
+	assert.True(t, acctest.ShouldSweep("scaleway_vpc", "id", "tf-tests-vpc", []string{"ci"}))
+	assert.False(t, acctest.ShouldSweep("scaleway_vpc", "id", "tf-tests-vpc", []string{"prod"}))
+
+	t.Setenv(acctest.SweepDryRunEnv, "true")
+	assert.False(t, acctest.ShouldSweep("scaleway_vpc", "id", "tf-tests-vpc", []string{"ci"}))
+}
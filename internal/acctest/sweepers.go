@@ -2,12 +2,45 @@ package acctest
 
 import (
 	"context"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/logging"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 )
 
+const (
+	// SweepNamePrefixEnv restricts sweepers to the resources whose name starts with its value
+	SweepNamePrefixEnv = "TF_SWEEP_NAME_PREFIX"
+	// SweepTagEnv restricts sweepers to the resources tagged with its value
+	SweepTagEnv = "TF_SWEEP_TAG"
+	// SweepDryRunEnv makes sweepers list the resources they would delete instead of deleting them
+	SweepDryRunEnv = "TF_SWEEP_DRY_RUN"
+)
+
+// ShouldSweep returns true if a sweeper should delete the given resource.
+// Resources not matching the TF_SWEEP_NAME_PREFIX and TF_SWEEP_TAG filters are kept,
+// matching resources are only logged when TF_SWEEP_DRY_RUN is enabled.
+func ShouldSweep(resourceType string, id string, name string, tags []string) bool {
+	if prefix := os.Getenv(SweepNamePrefixEnv); prefix != "" && !strings.HasPrefix(name, prefix) {
+		return false
+	}
+
+	if tag := os.Getenv(SweepTagEnv); tag != "" && !slices.Contains(tags, tag) {
+		return false
+	}
+
+	if dryRun, _ := strconv.ParseBool(os.Getenv(SweepDryRunEnv)); dryRun {
+		logging.L.Infof("sweeper: would delete %s %s (%s)", resourceType, id, name)
+		return false
+	}
+
+	return true
+}
+
 func Sweep(f func(scwClient *scw.Client) error) error {
 	ctx := context.Background()
 	m, err := meta.NewMeta(ctx, &meta.Config{
@@ -0,0 +1,25 @@
+package console
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// BaseURL is the URL of the Scaleway console
+const BaseURL = "https://console.scaleway.com"
+
+// URL returns the URL of the overview page of a resource in the Scaleway console.
+// path is the path of the resource type in the console, e.g. instance/servers, and locality its zone or region.
+func URL(path string, locality string, id string) string {
+	return fmt.Sprintf("%s/%s/%s/%s/overview", BaseURL, path, locality, id)
+}
+
+// URLSchema returns the schema of the computed console_url attribute
+func URLSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The URL of the resource in the Scaleway console",
+	}
+}
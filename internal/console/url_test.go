@@ -0,0 +1,15 @@
+package console_test
+
+import (
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/console"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURL(t *testing.T) {
+	assert.Equal(t,
+		"https://console.scaleway.com/instance/servers/fr-par-1/11111111-1111-1111-1111-111111111111/overview",
+		console.URL("instance/servers", "fr-par-1", "11111111-1111-1111-1111-111111111111"),
+	)
+}
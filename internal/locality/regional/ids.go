@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/scaleway-sdk-go/validation"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 )
 
@@ -31,7 +32,7 @@ func ExpandID(id interface{}) ID {
 	if len(tab) != 2 {
 		regionalID.ID = id.(string)
 	} else {
-		region, _ := scw.ParseRegion(tab[0])
+		region, _ := parseRegionOrZone(tab[0])
 		regionalID.ID = tab[1]
 		regionalID.Region = region
 	}
@@ -56,16 +57,33 @@ func ParseNestedID(regionalNestedID string) (region scw.Region, outerID, innerID
 }
 
 // ParseID parses a regionalID and extracts the resource region and id.
+// A zonal ID is accepted as well, the region is then inferred from the zone.
 func ParseID(regionalID string) (region scw.Region, id string, err error) {
 	loc, id, err := locality.ParseLocalizedID(regionalID)
 	if err != nil {
 		return
 	}
 
-	region, err = scw.ParseRegion(loc)
+	region, err = parseRegionOrZone(loc)
+	if err != nil {
+		err = fmt.Errorf("expected a regional ID like fr-par/%s, got %s: %w", id, regionalID, err)
+	}
 	return
 }
 
+// parseRegionOrZone parses a region, or the region of a zone.
+func parseRegionOrZone(loc string) (scw.Region, error) {
+	if validation.IsZone(loc) {
+		zone, err := scw.ParseZone(loc)
+		if err != nil {
+			return "", err
+		}
+		return zone.Region()
+	}
+
+	return scw.ParseRegion(loc)
+}
+
 func NewRegionalIDs(region scw.Region, ids []string) []string {
 	if ids == nil {
 		return nil
@@ -27,6 +27,12 @@ func TestParseRegionID(t *testing.T) {
 			id:         "my-id",
 			region:     scw.RegionFrPar,
 		},
+		{
+			name:       "zonal id",
+			localityID: "nl-ams-2/my-id",
+			id:         "my-id",
+			region:     scw.RegionNlAms,
+		},
 		{
 			name:       "empty",
 			localityID: "",
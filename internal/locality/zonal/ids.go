@@ -57,6 +57,9 @@ func ParseID(zonedID string) (zone scw.Zone, id string, err error) {
 	}
 
 	zone, err = scw.ParseZone(rawZone)
+	if err != nil {
+		err = fmt.Errorf("expected a zonal ID like fr-par-1/%s, got %s: %w", id, zonedID, err)
+	}
 	return
 }
 
@@ -52,3 +52,8 @@ func TestParseZonedID(t *testing.T) {
 		})
 	}
 }
+
+func TestParseZonedIDWithRegionalID(t *testing.T) {
+	_, _, err := zonal.ParseID("fr-par/my-id")
+	require.ErrorContains(t, err, "expected a zonal ID like fr-par-1/my-id, got fr-par/my-id")
+}
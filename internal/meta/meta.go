@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -42,6 +44,18 @@ type Meta struct {
 	httpClient *http.Client
 	// credentialsSource stores information about the source (env, profile, etc.) of each credential
 	credentialsSource *CredentialsSource
+	// features stores the opt-in behaviors set in the provider features block
+	features *Features
+}
+
+// Features are opt-in behaviors set in the provider features block.
+type Features struct {
+	// ObjectBucketPurgeOnDestroy empties every bucket on destroy, as if force_destroy was set
+	ObjectBucketPurgeOnDestroy bool
+	// InstanceServerDetachVolumesOnDestroy detaches the additional volumes of a server before deleting it
+	InstanceServerDetachVolumesOnDestroy bool
+	// InstanceServerCheckLocalVolumesSizeOnPlan checks the size of the local volumes of a server when planning
+	InstanceServerCheckLocalVolumesSizeOnPlan bool
 }
 
 func (m Meta) ScwClient() *scw.Client {
@@ -72,6 +86,20 @@ func (m Meta) ZoneSource() string {
 	return m.credentialsSource.DefaultZone
 }
 
+func (m Meta) Features() Features {
+	if m.features == nil {
+		return Features{}
+	}
+	return *m.features
+}
+
+// WithFeatures returns a copy of the meta using the features block of the given provider schema.
+func (m Meta) WithFeatures(d *schema.ResourceData) *Meta {
+	m.features = expandFeatures(d)
+
+	return &m
+}
+
 type Config struct {
 	ProviderSchema      *schema.ResourceData
 	TerraformVersion    string
@@ -119,15 +147,26 @@ func NewMeta(ctx context.Context, config *Config) (*Meta, error) {
 	// Create scaleway SDK client
 	////
 	opts := []scw.ClientOption{
-		scw.WithUserAgent(customizeUserAgent(version.Version, config.TerraformVersion)),
+		scw.WithUserAgent(customizeUserAgent(config.ProviderSchema, version.Version, config.TerraformVersion)),
 		scw.WithProfile(profile),
 	}
 
+	apiTimeout, err := expandAPITimeout(config.ProviderSchema)
+	if err != nil {
+		return nil, err
+	}
+
 	httpClient := &http.Client{Transport: transport.NewRetryableTransport(http.DefaultTransport)}
 	if config.HTTPClient != nil {
 		httpClient = config.HTTPClient
 	}
-	opts = append(opts, scw.WithHTTPClient(httpClient))
+
+	// The API timeout only bounds Scaleway API requests, the shared client is also used for uploads.
+	sdkHTTPClient := httpClient
+	if apiTimeout != nil {
+		sdkHTTPClient = &http.Client{Transport: transport.NewTimeoutTransport(httpClient.Transport, *apiTimeout)}
+	}
+	opts = append(opts, scw.WithHTTPClient(sdkHTTPClient))
 
 	scwClient, err := scw.NewClient(opts...)
 	if err != nil {
@@ -138,17 +177,67 @@ func NewMeta(ctx context.Context, config *Config) (*Meta, error) {
 		scwClient:         scwClient,
 		httpClient:        httpClient,
 		credentialsSource: credentialsSource,
+		features:          expandFeatures(config.ProviderSchema),
 	}, nil
 }
 
-func customizeUserAgent(providerVersion string, terraformVersion string) string {
-	userAgent := fmt.Sprintf("terraform-provider/%s terraform/%s", providerVersion, terraformVersion)
+func expandFeatures(d *schema.ResourceData) *Features {
+	features := &Features{}
+	if d == nil {
+		return features
+	}
+
+	if purge, exist := d.GetOk("features.0.object_bucket.0.purge_on_destroy"); exist {
+		features.ObjectBucketPurgeOnDestroy = purge.(bool)
+	}
+	if detach, exist := d.GetOk("features.0.instance_server.0.detach_volumes_on_destroy"); exist {
+		features.InstanceServerDetachVolumesOnDestroy = detach.(bool)
+	}
+	if check, exist := d.GetOk("features.0.instance_server.0.check_local_volumes_size_on_plan"); exist {
+		features.InstanceServerCheckLocalVolumesSizeOnPlan = check.(bool)
+	}
+
+	return features
+}
+
+func expandAPITimeout(d *schema.ResourceData) (*time.Duration, error) {
+	if d == nil {
+		return nil, nil
+	}
+
+	rawTimeout, exist := d.GetOk("api_timeout")
+	if !exist {
+		return nil, nil
+	}
+
+	timeout, err := time.ParseDuration(rawTimeout.(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid api_timeout: %w", err)
+	}
+
+	return &timeout, nil
+}
+
+// customizeUserAgent builds the part of the User-Agent appended by the provider to the one of the SDK.
+// The provider and Terraform versions are left out when telemetry is disabled.
+func customizeUserAgent(d *schema.ResourceData, providerVersion string, terraformVersion string) string {
+	userAgentParts := []string(nil)
+
+	if d == nil || !d.Get("disable_telemetry").(bool) {
+		userAgentParts = append(userAgentParts, fmt.Sprintf("terraform-provider/%s terraform/%s", providerVersion, terraformVersion))
+	}
 
 	if appendUserAgent := os.Getenv(appendUserAgentEnvVar); appendUserAgent != "" {
-		userAgent += " " + appendUserAgent
+		userAgentParts = append(userAgentParts, appendUserAgent)
+	}
+
+	if d != nil {
+		if suffix, exist := d.GetOk("user_agent_suffix"); exist {
+			userAgentParts = append(userAgentParts, suffix.(string))
+		}
 	}
 
-	return userAgent
+	return strings.Join(userAgentParts, " ")
 }
 
 //gocyclo:ignore
@@ -0,0 +1,72 @@
+package mutexkv_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/mutexkv"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMutexKVLock(t *testing.T) {
+	mkv := mutexkv.NewMutexKV()
+
+	mkv.Lock("foo")
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		mkv.Lock("foo")
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		t.Fatal("Second lock was able to be taken. This shouldn't happen.")
+	case <-time.After(50 * time.Millisecond): // lintignore: R018
+		// pass
+	}
+
+	mkv.Unlock("foo")
+	<-doneCh
+}
+
+func TestMutexKVDifferentKeys(t *testing.T) {
+	mkv := mutexkv.NewMutexKV()
+
+	mkv.Lock("foo")
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		mkv.Lock("bar")
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(50 * time.Millisecond): // lintignore: R018
+		t.Fatal("Second lock on a different key was blocked. This shouldn't happen.")
+	}
+}
+
+func TestMutexKVSerializes(t *testing.T) {
+	mkv := mutexkv.NewMutexKV()
+	counter := 0
+	wg := sync.WaitGroup{}
+
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mkv.Lock("server")
+			defer mkv.Unlock("server")
+			counter++
+		}()
+	}
+
+	wg.Wait()
+	assert.Equal(t, 50, counter)
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/block"
+)
+
+// moveStateFunc converts the raw state of a source resource into the attributes of the target resource.
+type moveStateFunc func(source map[string]interface{}) (map[string]interface{}, error)
+
+// resourceMoves lists, by target resource type, the source resource types that can be moved with a `moved` block.
+var resourceMoves = map[string]map[string]moveStateFunc{
+	"scaleway_block_snapshot": {
+		"scaleway_instance_snapshot": block.MoveStateFromInstanceSnapshot,
+	},
+	"scaleway_block_volume": {
+		"scaleway_instance_volume": block.MoveStateFromInstanceVolume,
+	},
+}
+
+// providerServer wraps the SDK provider server to handle the RPCs the SDK does not support.
+type providerServer struct {
+	tfprotov5.ProviderServer
+
+	provider *schema.Provider
+}
+
+// ProviderServer returns the gRPC server of the provider, with support for moving state across resource types.
+func ProviderServer(config *Config) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		p := Provider(config)()
+
+		return &providerServer{
+			ProviderServer: p.GRPCProvider(),
+			provider:       p,
+		}
+	}
+}
+
+func (s *providerServer) MoveResourceState(ctx context.Context, req *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	move, ok := resourceMoves[req.TargetTypeName][req.SourceTypeName]
+	if !ok {
+		return s.ProviderServer.MoveResourceState(ctx, req)
+	}
+
+	targetState, err := moveResourceState(s.provider.ResourcesMap[req.TargetTypeName], req.SourceState, move)
+	if err != nil {
+		return &tfprotov5.MoveResourceStateResponse{
+			Diagnostics: []*tfprotov5.Diagnostic{
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "Unable to move resource state",
+					Detail:   fmt.Sprintf("Moving %s to %s failed: %s", req.SourceTypeName, req.TargetTypeName, err),
+				},
+			},
+		}, nil
+	}
+
+	return &tfprotov5.MoveResourceStateResponse{
+		TargetState: targetState,
+	}, nil
+}
+
+func moveResourceState(target *schema.Resource, sourceState *tfprotov5.RawState, move moveStateFunc) (*tfprotov5.DynamicValue, error) {
+	if sourceState == nil || len(sourceState.JSON) == 0 {
+		return nil, errors.New("source state is empty")
+	}
+
+	source := map[string]interface{}{}
+	if err := json.Unmarshal(sourceState.JSON, &source); err != nil {
+		return nil, fmt.Errorf("failed to decode source state: %w", err)
+	}
+
+	attributes, err := move(source)
+	if err != nil {
+		return nil, err
+	}
+
+	id, _ := attributes["id"].(string)
+	if id == "" {
+		return nil, errors.New("source state has no id")
+	}
+
+	d := target.Data(nil)
+	d.SetId(id)
+	for key, value := range attributes {
+		if key == "id" {
+			continue
+		}
+		if err := d.Set(key, value); err != nil {
+			return nil, fmt.Errorf("failed to set %s: %w", key, err)
+		}
+	}
+
+	stateType := target.CoreConfigSchema().ImpliedType()
+	stateValue, err := d.State().AttrsAsObjectValue(stateType)
+	if err != nil {
+		return nil, err
+	}
+
+	stateMsgPack, err := msgpack.Marshal(stateValue, stateType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tfprotov5.DynamicValue{MsgPack: stateMsgPack}, nil
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/ipam"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/jobs"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/k8s"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/keymanager"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/lb"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/marketplace"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/mnq"
@@ -115,12 +116,76 @@ func Provider(config *Config) plugin.ProviderFunc {
 					Optional:    true,
 					Description: "The Scaleway API URL to use.",
 				},
+				"api_timeout": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "The maximum duration of each Scaleway API request, e.g. 30s. Requests are not bounded by default.",
+					ValidateDiagFunc: verify.IsDuration(),
+				},
+				"user_agent_suffix": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "A string appended to the User-Agent of every Scaleway API request, e.g. to identify the Terraform stack making them.",
+				},
+				"disable_telemetry": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Stop sending the provider and Terraform versions in the User-Agent of Scaleway API requests.",
+				},
+				"features": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Opt-in behaviors applied to every resource of the provider.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"object_bucket": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"purge_on_destroy": {
+											Type:        schema.TypeBool,
+											Optional:    true,
+											Default:     false,
+											Description: "Delete the objects of every bucket on destroy, as if force_destroy was set.",
+										},
+									},
+								},
+							},
+							"instance_server": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"detach_volumes_on_destroy": {
+											Type:        schema.TypeBool,
+											Optional:    true,
+											Default:     false,
+											Description: "Detach the additional block volumes of a server before deleting it. Local volumes are not detached.",
+										},
+										"check_local_volumes_size_on_plan": {
+											Type:        schema.TypeBool,
+											Optional:    true,
+											Default:     false,
+											Description: "Check that the local volumes of a server fit in the local storage of its type when planning.",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 
 			ResourcesMap: map[string]*schema.Resource{
 				"scaleway_account_project":                     account.ResourceProject(),
 				"scaleway_account_ssh_key":                     iam.ResourceSSKKey(),
 				"scaleway_apple_silicon_server":                applesilicon.ResourceServer(),
+				"scaleway_baremetal_private_network":           baremetal.ResourcePrivateNetwork(),
 				"scaleway_baremetal_server":                    baremetal.ResourceServer(),
 				"scaleway_block_snapshot":                      block.ResourceSnapshot(),
 				"scaleway_block_volume":                        block.ResourceVolume(),
@@ -153,8 +218,11 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_iam_ssh_key":                         iam.ResourceSSKKey(),
 				"scaleway_iam_user":                            iam.ResourceUser(),
 				"scaleway_inference_deployment":                inference.ResourceDeployment(),
+				"scaleway_inference_deployment_acl":            inference.ResourceDeploymentACL(),
 				"scaleway_instance_image":                      instance.ResourceImage(),
+				"scaleway_instance_image_from_server":          instance.ResourceImageFromServer(),
 				"scaleway_instance_ip":                         instance.ResourceIP(),
+				"scaleway_instance_ip_attachment":              instance.ResourceIPAttachment(),
 				"scaleway_instance_ip_reverse_dns":             instance.ResourceIPReverseDNS(),
 				"scaleway_instance_placement_group":            instance.ResourcePlacementGroup(),
 				"scaleway_instance_private_nic":                instance.ResourcePrivateNIC(),
@@ -173,6 +241,7 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_job_definition":                      jobs.ResourceDefinition(),
 				"scaleway_k8s_cluster":                         k8s.ResourceCluster(),
 				"scaleway_k8s_pool":                            k8s.ResourcePool(),
+				"scaleway_key_manager_key":                     keymanager.ResourceKey(),
 				"scaleway_lb":                                  lb.ResourceLb(),
 				"scaleway_lb_acl":                              lb.ResourceACL(),
 				"scaleway_lb_backend":                          lb.ResourceBackend(),
@@ -193,8 +262,10 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_mongodb_snapshot":                    mongodb.ResourceSnapshot(),
 				"scaleway_object":                              object.ResourceObject(),
 				"scaleway_object_bucket":                       object.ResourceBucket(),
+				"scaleway_object_bucket_access_key":            object.ResourceBucketAccessKey(),
 				"scaleway_object_bucket_acl":                   object.ResourceBucketACL(),
 				"scaleway_object_bucket_lock_configuration":    object.ResourceLockConfiguration(),
+				"scaleway_object_bucket_notification":          object.ResourceBucketNotification(),
 				"scaleway_object_bucket_policy":                object.ResourceBucketPolicy(),
 				"scaleway_object_bucket_website_configuration": object.ResourceBucketWebsiteConfiguration(),
 				"scaleway_rdb_acl":                             rdb.ResourceACL(),
@@ -205,6 +276,7 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_rdb_read_replica":                    rdb.ResourceReadReplica(),
 				"scaleway_rdb_user":                            rdb.ResourceUser(),
 				"scaleway_redis_cluster":                       redis.ResourceCluster(),
+				"scaleway_registry_image":                      registry.ResourceImage(),
 				"scaleway_registry_namespace":                  registry.ResourceNamespace(),
 				"scaleway_sdb_sql_database":                    sdb.ResourceDatabase(),
 				"scaleway_secret":                              secret.ResourceSecret(),
@@ -252,6 +324,7 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_iam_application":                     iam.DataSourceApplication(),
 				"scaleway_iam_group":                           iam.DataSourceGroup(),
 				"scaleway_iam_ssh_key":                         iam.DataSourceSSHKey(),
+				"scaleway_iam_ssh_keys":                        iam.DataSourceSSHKeys(),
 				"scaleway_iam_user":                            iam.DataSourceUser(),
 				"scaleway_iam_api_key":                         iam.DataSourceAPIKey(),
 				"scaleway_instance_image":                      instance.DataSourceImage(),
@@ -259,7 +332,9 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_instance_placement_group":            instance.DataSourcePlacementGroup(),
 				"scaleway_instance_private_nic":                instance.DataSourcePrivateNIC(),
 				"scaleway_instance_security_group":             instance.DataSourceSecurityGroup(),
+				"scaleway_instance_security_groups":            instance.DataSourceSecurityGroups(),
 				"scaleway_instance_server":                     instance.DataSourceServer(),
+				"scaleway_instance_server_type":                instance.DataSourceServerType(),
 				"scaleway_instance_servers":                    instance.DataSourceServers(),
 				"scaleway_instance_snapshot":                   instance.DataSourceSnapshot(),
 				"scaleway_instance_volume":                     instance.DataSourceVolume(),
@@ -268,8 +343,10 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_ipam_ip":                             ipam.DataSourceIP(),
 				"scaleway_ipam_ips":                            ipam.DataSourceIPs(),
 				"scaleway_k8s_cluster":                         k8s.DataSourceCluster(),
+				"scaleway_k8s_cluster_kubeconfig":              k8s.DataSourceClusterKubeconfig(),
 				"scaleway_k8s_pool":                            k8s.DataSourcePool(),
 				"scaleway_k8s_version":                         k8s.DataSourceVersion(),
+				"scaleway_key_manager_key":                     keymanager.DataSourceKey(),
 				"scaleway_lb":                                  lb.DataSourceLb(),
 				"scaleway_lb_acls":                             lb.DataSourceACLs(),
 				"scaleway_lb_backend":                          lb.DataSourceBackend(),
@@ -279,6 +356,7 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_lb_frontends":                        lb.DataSourceFrontends(),
 				"scaleway_lb_ip":                               lb.DataSourceIP(),
 				"scaleway_lb_ips":                              lb.DataSourceIPs(),
+				"scaleway_lb_offers":                           lb.DataSourceOffers(),
 				"scaleway_lb_route":                            lb.DataSourceRoute(),
 				"scaleway_lb_routes":                           lb.DataSourceRoutes(),
 				"scaleway_lbs":                                 lb.DataSourceLbs(),
@@ -303,6 +381,7 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_vpc":                                 vpc.DataSourceVPC(),
 				"scaleway_vpc_gateway_network":                 vpcgw.DataSourceNetwork(),
 				"scaleway_vpc_private_network":                 vpc.DataSourcePrivateNetwork(),
+				"scaleway_vpc_private_networks":                vpc.DataSourcePrivateNetworks(),
 				"scaleway_vpc_public_gateway":                  vpcgw.DataSourceVPCPublicGateway(),
 				"scaleway_vpc_public_gateway_dhcp":             vpcgw.DataSourceDHCP(),
 				"scaleway_vpc_public_gateway_dhcp_reservation": vpcgw.DataSourceDHCPReservation(),
@@ -321,8 +400,9 @@ func Provider(config *Config) plugin.ProviderFunc {
 			terraformVersion := p.TerraformVersion
 
 			// If we provide meta in config use it. This is useful for tests
+			// The features block is still read from the configuration, so tests may enable them.
 			if config.Meta != nil {
-				return config.Meta, nil
+				return config.Meta.WithFeatures(data), nil
 			}
 
 			m, err := meta.NewMeta(ctx, &meta.Config{
@@ -3,16 +3,24 @@ package provider_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/provider"
 	iamchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/iam/testfuncs"
 	instancechecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/testfuncs"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -128,3 +136,171 @@ func TestAccProvider_SSHKeys(t *testing.T) {
 		},
 	})
 }
+
+func TestProviderFeatures(t *testing.T) {
+	ctx := context.Background()
+
+	p := provider.Provider(provider.DefaultConfig())()
+	d := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+		"features": []interface{}{
+			map[string]interface{}{
+				"object_bucket": []interface{}{
+					map[string]interface{}{
+						"purge_on_destroy": true,
+					},
+				},
+			},
+		},
+	})
+
+	m, err := meta.NewMeta(ctx, &meta.Config{
+		ProviderSchema:   d,
+		TerraformVersion: "terraform-tests",
+	})
+	require.NoError(t, err)
+	require.Equal(t, meta.Features{ObjectBucketPurgeOnDestroy: true}, m.Features())
+}
+
+func TestProviderUserAgent(t *testing.T) {
+	ctx := context.Background()
+
+	userAgent := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"servers": [], "total_count": 0}`))
+	}))
+	defer server.Close()
+
+	p := provider.Provider(provider.DefaultConfig())()
+	d := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+		"access_key":        "SCWXXXXXXXXXXXXXXXXX",
+		"secret_key":        "11111111-1111-1111-1111-111111111111",
+		"project_id":        "11111111-1111-1111-1111-111111111111",
+		"api_url":           server.URL,
+		"user_agent_suffix": "stack/network",
+		"disable_telemetry": true,
+	})
+
+	m, err := meta.NewMeta(ctx, &meta.Config{
+		ProviderSchema:   d,
+		TerraformVersion: "terraform-tests",
+		HTTPClient:       server.Client(),
+	})
+	require.NoError(t, err)
+
+	_, err = instanceSDK.NewAPI(m.ScwClient()).ListServers(&instanceSDK.ListServersRequest{
+		Zone: scw.ZoneFrPar1,
+	})
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(userAgent, " stack/network"), userAgent)
+	assert.NotContains(t, userAgent, "terraform-tests")
+}
+
+func TestProviderAPITimeout(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(200 * time.Millisecond):
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"servers": [], "total_count": 0}`))
+	}))
+	defer server.Close()
+
+	p := provider.Provider(provider.DefaultConfig())()
+	d := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+		"access_key":  "SCWXXXXXXXXXXXXXXXXX",
+		"secret_key":  "11111111-1111-1111-1111-111111111111",
+		"project_id":  "11111111-1111-1111-1111-111111111111",
+		"api_url":     server.URL,
+		"api_timeout": "50ms",
+	})
+
+	m, err := meta.NewMeta(ctx, &meta.Config{
+		ProviderSchema:   d,
+		TerraformVersion: "terraform-tests",
+		HTTPClient:       server.Client(),
+	})
+	require.NoError(t, err)
+
+	_, err = instanceSDK.NewAPI(m.ScwClient()).ListServers(&instanceSDK.ListServersRequest{
+		Zone: scw.ZoneFrPar1,
+	})
+	require.ErrorContains(t, err, "did not answer within the API timeout of 50ms")
+
+	// The shared client, used for uploads, is not bounded by the API timeout
+	resp, err := m.HTTPClient().Get(server.URL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestProviderMoveResourceState(t *testing.T) {
+	ctx := context.Background()
+	server := provider.ProviderServer(provider.DefaultConfig())()
+
+	resp, err := server.MoveResourceState(ctx, &tfprotov5.MoveResourceStateRequest{
+		SourceTypeName: "scaleway_instance_volume",
+		TargetTypeName: "scaleway_block_volume",
+		SourceState: &tfprotov5.RawState{
+			JSON: []byte(`{"id":"fr-par-1/11111111-1111-1111-1111-111111111111","name":"data","type":"sbs_volume","size_in_gb":20,"tags":["foo"],"zone":"fr-par-1","project_id":"22222222-2222-2222-2222-222222222222","server_id":""}`),
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Diagnostics)
+
+	stateType := provider.Provider(provider.DefaultConfig())().ResourcesMap["scaleway_block_volume"].CoreConfigSchema().ImpliedType()
+	state, err := msgpack.Unmarshal(resp.TargetState.MsgPack, stateType)
+	require.NoError(t, err)
+	assert.Equal(t, "fr-par-1/11111111-1111-1111-1111-111111111111", state.GetAttr("id").AsString())
+	assert.Equal(t, "data", state.GetAttr("name").AsString())
+	assert.Equal(t, "fr-par-1", state.GetAttr("zone").AsString())
+	assert.True(t, state.GetAttr("iops").IsNull())
+
+	resp, err = server.MoveResourceState(ctx, &tfprotov5.MoveResourceStateRequest{
+		SourceTypeName: "scaleway_instance_volume",
+		TargetTypeName: "scaleway_block_volume",
+		SourceState: &tfprotov5.RawState{
+			JSON: []byte(`{"id":"fr-par-1/11111111-1111-1111-1111-111111111111","type":"l_ssd","zone":"fr-par-1"}`),
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Diagnostics, 1)
+	assert.Equal(t, tfprotov5.DiagnosticSeverityError, resp.Diagnostics[0].Severity)
+
+	// A b_ssd volume not yet migrated to Block Storage is not known by the Block Storage API
+	resp, err = server.MoveResourceState(ctx, &tfprotov5.MoveResourceStateRequest{
+		SourceTypeName: "scaleway_instance_volume",
+		TargetTypeName: "scaleway_block_volume",
+		SourceState: &tfprotov5.RawState{
+			JSON: []byte(`{"id":"fr-par-1/11111111-1111-1111-1111-111111111111","type":"b_ssd","zone":"fr-par-1"}`),
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Diagnostics, 1)
+	assert.Equal(t, tfprotov5.DiagnosticSeverityError, resp.Diagnostics[0].Severity)
+
+	resp, err = server.MoveResourceState(ctx, &tfprotov5.MoveResourceStateRequest{
+		SourceTypeName: "scaleway_instance_snapshot",
+		TargetTypeName: "scaleway_block_snapshot",
+		SourceState: &tfprotov5.RawState{
+			JSON: []byte(`{"id":"fr-par-1/33333333-3333-3333-3333-333333333333","name":"backup","type":"b_ssd","zone":"fr-par-1"}`),
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Diagnostics, 1)
+	assert.Equal(t, tfprotov5.DiagnosticSeverityError, resp.Diagnostics[0].Severity)
+
+	resp, err = server.MoveResourceState(ctx, &tfprotov5.MoveResourceStateRequest{
+		SourceTypeName: "scaleway_instance_snapshot",
+		TargetTypeName: "scaleway_block_snapshot",
+		SourceState: &tfprotov5.RawState{
+			JSON: []byte(`{"id":"fr-par-1/33333333-3333-3333-3333-333333333333","name":"backup","type":"sbs_snapshot","zone":"fr-par-1"}`),
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Diagnostics)
+}
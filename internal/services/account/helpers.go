@@ -2,6 +2,7 @@ package account
 
 import (
 	"errors"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	accountSDK "github.com/scaleway/scaleway-sdk-go/api/account/v3"
@@ -10,6 +11,8 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
+const defaultProjectTimeout = 20 * time.Minute
+
 func NewProjectAPI(m interface{}) *accountSDK.ProjectAPI {
 	return accountSDK.NewProjectAPI(meta.ExtractScwClient(m))
 }
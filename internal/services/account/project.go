@@ -22,6 +22,10 @@ func ResourceProject() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete:  schema.DefaultTimeout(defaultProjectTimeout),
+			Default: schema.DefaultTimeout(defaultProjectTimeout),
+		},
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
 			"name": {
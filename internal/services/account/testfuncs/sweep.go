@@ -33,6 +33,9 @@ func testSweepAccountProject(_ string) error {
 			if project.ID == req.OrganizationID || !acctest.IsTestResource(project.Name) {
 				continue
 			}
+			if !acctest.ShouldSweep("scaleway_account_project", project.ID, project.Name, nil) {
+				continue
+			}
 			err = accountAPI.DeleteProject(&accountSDK.ProjectAPIDeleteProjectRequest{
 				ProjectID: project.ID,
 			})
@@ -27,6 +27,9 @@ func testSweepAppleSiliconServer(_ string) error {
 		}
 
 		for _, server := range listServers.Servers {
+			if !acctest.ShouldSweep("scaleway_apple_silicon_server", server.ID, server.Name, nil) {
+				continue
+			}
 			errDelete := asAPI.DeleteServer(&applesiliconSDK.DeleteServerRequest{
 				ServerID: server.ID,
 				Zone:     zone,
@@ -5,9 +5,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
 	baremetalV3 "github.com/scaleway/scaleway-sdk-go/api/baremetal/v3"
@@ -70,6 +73,17 @@ func NewPrivateNetworkAPIWithZoneAndID(m interface{}, id string) (*baremetalV3.P
 	return privateNetworkAPI, zonal.NewID(zone, ID), nil
 }
 
+// NewPrivateNetworkAPIWithZoneAndNestedID returns a private network API with zone, server ID and private network ID extracted from the state
+func NewPrivateNetworkAPIWithZoneAndNestedID(m interface{}, zonedNestedID string) (*baremetalV3.PrivateNetworkAPI, scw.Zone, string, string, error) {
+	privateNetworkAPI := baremetalV3.NewPrivateNetworkAPI(meta.ExtractScwClient(m))
+
+	zone, serverID, privateNetworkID, err := zonal.ParseNestedID(zonedNestedID)
+	if err != nil {
+		return nil, "", "", "", err
+	}
+	return privateNetworkAPI, zone, serverID, privateNetworkID, nil
+}
+
 func detachAllPrivateNetworkFromServer(ctx context.Context, d *schema.ResourceData, m interface{}, serverID string) error {
 	privateNetworkAPI, zone, err := newPrivateNetworkAPIWithZone(d, m)
 	if err != nil {
@@ -158,6 +172,71 @@ func compareOptions(slice1, slice2 []*baremetal.ServerOption) []*baremetal.Serve
 	return diff
 }
 
+// installConfigDiff is implemented by both schema.ResourceDiff and schema.ResourceData
+type installConfigDiff interface {
+	GetChange(key string) (interface{}, interface{})
+	GetRawConfig() cty.Value
+}
+
+// changedInstallAttributes returns the install attributes of an installed server that the configuration changes.
+// Differences that do not come from the configuration are ignored: the order of the SSH keys, which the API may
+// not keep, and the user or password when they are not configured or were never known, e.g. after an import.
+func changedInstallAttributes(d installConfigDiff) []string {
+	oldOS, newOS := d.GetChange("os")
+	if oldOS.(string) == "" {
+		// A server created with install_config_afterward is not installed yet
+		return nil
+	}
+
+	rawConfig := d.GetRawConfig()
+	isConfigured := func(attribute string) bool {
+		return !rawConfig.IsNull() && rawConfig.IsKnown() && !rawConfig.GetAttr(attribute).IsNull()
+	}
+
+	changedAttributes := []string(nil)
+	if locality.ExpandID(oldOS) != locality.ExpandID(newOS) {
+		changedAttributes = append(changedAttributes, "os")
+	}
+
+	oldSSHKeyIDs, newSSHKeyIDs := d.GetChange("ssh_key_ids")
+	if !sameStrings(types.ExpandStrings(oldSSHKeyIDs), types.ExpandStrings(newSSHKeyIDs)) {
+		changedAttributes = append(changedAttributes, "ssh_key_ids")
+	}
+
+	for _, attribute := range []string{"user", "password"} {
+		oldValue, newValue := d.GetChange(attribute)
+		if isConfigured(attribute) && oldValue.(string) != "" && oldValue != newValue {
+			changedAttributes = append(changedAttributes, attribute)
+		}
+	}
+
+	return changedAttributes
+}
+
+// sameStrings returns whether both slices hold the same strings, in any order
+func sameStrings(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+
+	return slices.Equal(a, b)
+}
+
+// customDiffReinstallGuard refuses configuration changes reinstalling an installed server, and erasing its data, unless reinstall_on_config_changes is set
+func customDiffReinstallGuard() func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	return func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+		if diff.Id() == "" || diff.Get("reinstall_on_config_changes").(bool) {
+			return nil
+		}
+
+		if changedAttributes := changedInstallAttributes(diff); len(changedAttributes) > 0 {
+			return fmt.Errorf("changing %s reinstalls the server and erases its data, set reinstall_on_config_changes to true to allow it", strings.Join(changedAttributes, ", "))
+		}
+
+		return nil
+	}
+}
+
 // customDiffPrivateNetworkOption checks that the private_network option has been set if there is a private_network
 func customDiffPrivateNetworkOption() func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
 	return func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
@@ -0,0 +1,199 @@
+package baremetal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	baremetalV3 "github.com/scaleway/scaleway-sdk-go/api/baremetal/v3"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func ResourcePrivateNetwork() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourcePrivateNetworkCreate,
+		ReadContext:   ResourcePrivateNetworkRead,
+		DeleteContext: ResourcePrivateNetworkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultServerTimeout),
+			Create:  schema.DefaultTimeout(defaultServerTimeout),
+			Delete:  schema.DefaultTimeout(defaultServerTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The ID of the server to attach to the private network",
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: dsf.Locality,
+			},
+			"private_network_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The ID of the private network",
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: dsf.Locality,
+			},
+			"ipam_ip_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				},
+				Description: "List of IPAM IP IDs to attach to the server",
+			},
+			"vlan": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The VLAN ID associated to the private network",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the private network attachment",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the creation of the private network attachment",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the last update of the private network attachment",
+			},
+			"zone": zonal.Schema(),
+		},
+		CustomizeDiff: cdf.LocalityCheck("server_id"),
+	}
+}
+
+func ResourcePrivateNetworkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	privateNetworkAPI, zone, err := newPrivateNetworkAPIWithZone(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serverID := locality.ExpandID(d.Get("server_id"))
+
+	_, err = waitForServerPrivateNetwork(ctx, privateNetworkAPI, zone, serverID, d.Timeout(schema.TimeoutCreate))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	privateNetwork, err := privateNetworkAPI.AddServerPrivateNetwork(&baremetalV3.PrivateNetworkAPIAddServerPrivateNetworkRequest{
+		Zone:             zone,
+		ServerID:         serverID,
+		PrivateNetworkID: locality.ExpandID(d.Get("private_network_id")),
+		IpamIPIDs:        locality.ExpandIDs(d.Get("ipam_ip_ids")),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(zonal.NewNestedIDString(zone, privateNetwork.ServerID, privateNetwork.PrivateNetworkID))
+
+	_, err = waitForServerPrivateNetwork(ctx, privateNetworkAPI, zone, serverID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ResourcePrivateNetworkRead(ctx, d, m)
+}
+
+func ResourcePrivateNetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	privateNetworkAPI, zone, serverID, privateNetworkID, err := NewPrivateNetworkAPIWithZoneAndNestedID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := privateNetworkAPI.ListServerPrivateNetworks(&baremetalV3.PrivateNetworkAPIListServerPrivateNetworksRequest{
+		Zone:             zone,
+		ServerID:         &serverID,
+		PrivateNetworkID: &privateNetworkID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		if httperrors.Is404(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to list server's private networks: %w", err))
+	}
+
+	var privateNetwork *baremetalV3.ServerPrivateNetwork
+	for _, pn := range res.ServerPrivateNetworks {
+		if pn.PrivateNetworkID == privateNetworkID {
+			privateNetwork = pn
+			break
+		}
+	}
+	if privateNetwork == nil {
+		d.SetId("")
+		return nil
+	}
+
+	region, err := zone.Region()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("server_id", zonal.NewIDString(zone, privateNetwork.ServerID))
+	_ = d.Set("private_network_id", regional.NewIDString(region, privateNetwork.PrivateNetworkID))
+	_ = d.Set("ipam_ip_ids", regional.NewRegionalIDs(region, privateNetwork.IpamIPIDs))
+	_ = d.Set("vlan", types.FlattenUint32Ptr(privateNetwork.Vlan))
+	_ = d.Set("status", privateNetwork.Status.String())
+	_ = d.Set("created_at", types.FlattenTime(privateNetwork.CreatedAt))
+	_ = d.Set("updated_at", types.FlattenTime(privateNetwork.UpdatedAt))
+	_ = d.Set("zone", zone.String())
+
+	return nil
+}
+
+func ResourcePrivateNetworkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	privateNetworkAPI, zone, serverID, privateNetworkID, err := NewPrivateNetworkAPIWithZoneAndNestedID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = waitForServerPrivateNetwork(ctx, privateNetworkAPI, zone, serverID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		if httperrors.Is404(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	err = privateNetworkAPI.DeleteServerPrivateNetwork(&baremetalV3.PrivateNetworkAPIDeleteServerPrivateNetworkRequest{
+		Zone:             zone,
+		ServerID:         serverID,
+		PrivateNetworkID: privateNetworkID,
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	_, err = waitForServerPrivateNetwork(ctx, privateNetworkAPI, zone, serverID, d.Timeout(schema.TimeoutDelete))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package baremetal_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	baremetalchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/baremetal/testfuncs"
+)
+
+func TestAccPrivateNetwork_Basic(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	if !IsOfferAvailable(OfferID, Zone, tt) {
+		t.Skip("Offer is out of stock")
+	}
+
+	name := "TestAccPrivateNetwork_Basic"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      baremetalchecks.CheckServerDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "scaleway_baremetal_os" "my_os" {
+						zone    = "fr-par-1"
+						name    = "Ubuntu"
+						version = "22.04 LTS (Jammy Jellyfish)"
+					}
+
+					data "scaleway_baremetal_option" "private_network" {
+						zone = "fr-par-1"
+						name = "Private Network"
+					}
+
+					resource "scaleway_vpc_private_network" "pn" {
+						name = "%[1]s"
+					}
+
+					resource "scaleway_iam_ssh_key" "base" {
+						name       = "%[1]s"
+						public_key = "%[2]s"
+					}
+
+					resource "scaleway_baremetal_server" "base" {
+						name        = "%[1]s"
+						zone        = "fr-par-1"
+						offer       = "%[3]s"
+						os          = data.scaleway_baremetal_os.my_os.os_id
+						ssh_key_ids = [ scaleway_iam_ssh_key.base.id ]
+
+						options {
+							id = data.scaleway_baremetal_option.private_network.option_id
+						}
+					}
+
+					resource "scaleway_baremetal_private_network" "main" {
+						server_id          = scaleway_baremetal_server.base.id
+						private_network_id = scaleway_vpc_private_network.pn.id
+					}
+				`, name, SSHKeyBaremetal, OfferName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("scaleway_baremetal_private_network.main", "server_id", "scaleway_baremetal_server.base", "id"),
+					resource.TestCheckResourceAttrPair("scaleway_baremetal_private_network.main", "private_network_id", "scaleway_vpc_private_network.pn", "id"),
+					resource.TestCheckResourceAttrSet("scaleway_baremetal_private_network.main", "vlan"),
+					resource.TestCheckResourceAttr("scaleway_baremetal_private_network.main", "status", "attached"),
+				),
+			},
+			{
+				// The attachment is not reported as drift on the server once it is refreshed
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_baremetal_server.base", "private_network.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "scaleway_baremetal_private_network.main",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -100,7 +100,7 @@ func ResourceServer() *schema.Resource {
 				Description: `Array of SSH key IDs allowed to SSH to the server
 
 **NOTE** : If you are attempting to update your SSH key IDs, it will induce the reinstall of your server. 
-If this behaviour is wanted, please set 'reinstall_on_ssh_key_changes' argument to true.`,
+If this behaviour is wanted, please set 'reinstall_on_config_changes' argument to true.`,
 			},
 			"user": {
 				Type:        schema.TypeString,
@@ -130,7 +130,7 @@ If this behaviour is wanted, please set 'reinstall_on_ssh_key_changes' argument
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
-				Description: "If True, this boolean allows to reinstall the server on SSH key IDs, user or password changes",
+				Description: "If True, this boolean allows to reinstall the server on OS, SSH key IDs, user or password changes",
 			},
 			"install_config_afterward": {
 				Type:        schema.TypeBool,
@@ -266,6 +266,7 @@ If this behaviour is wanted, please set 'reinstall_on_ssh_key_changes' argument
 		CustomizeDiff: customdiff.Sequence(
 			cdf.LocalityCheck("private_network.#.id"),
 			customDiffPrivateNetworkOption(),
+			customDiffReinstallGuard(),
 		),
 	}
 }
@@ -486,7 +487,10 @@ func ResourceServerRead(ctx context.Context, d *schema.ResourceData, m interface
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	_ = d.Set("private_network", flattenPrivateNetworks(pnRegion, listPrivateNetworks.ServerPrivateNetworks))
+	// Private networks attached with scaleway_baremetal_private_network are only tracked there
+	if _, ok := d.GetOk("private_network"); ok {
+		_ = d.Set("private_network", flattenPrivateNetworks(pnRegion, listPrivateNetworks.ServerPrivateNetworks))
+	}
 
 	return nil
 }
@@ -613,7 +617,10 @@ func ResourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		ServicePassword: types.ExpandStringPtr(d.Get("service_password")),
 	}
 
-	if d.HasChange("os") {
+	// The server is installed once its install config is provided, then reinstalled when the configuration changes it
+	oldOS, _ := d.GetChange("os")
+	firstInstall := oldOS.(string) == "" && d.Get("os").(string) != ""
+	if firstInstall || len(changedInstallAttributes(d)) > 0 {
 		if diags := validateInstallConfig(ctx, d, m); len(diags) > 0 {
 			return diags
 		}
@@ -628,33 +635,7 @@ func ResourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		}
 	}
 
-	var diags diag.Diagnostics
-
-	if d.HasChanges("ssh_key_ids", "user", "password", "reinstall_on_config_changes") {
-		if !d.Get("reinstall_on_config_changes").(bool) && !d.HasChange("os") {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  "Changes have been made on your config",
-				Detail: "[WARN] This change induce the reinstall of your server. " +
-					"If this behaviour is wanted, please set 'reinstall_on_config_changes' argument to true",
-			})
-		} else {
-			if diags := validateInstallConfig(ctx, d, m); len(diags) > 0 {
-				return diags
-			}
-			err = installServer(ctx, d, api, installReq)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			_, err = waitForServerInstall(ctx, api, zonedID.Zone, zonedID.ID, d.Timeout(schema.TimeoutUpdate))
-			if err != nil {
-				return diag.FromErr(err)
-			}
-		}
-	}
-
-	return append(diags, ResourceServerRead(ctx, d, m)...)
+	return ResourceServerRead(ctx, d, m)
 }
 
 func ResourceServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
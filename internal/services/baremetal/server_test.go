@@ -1146,3 +1146,71 @@ func testAccCheckBaremetalServerHasPrivateNetwork(tt *acctest.TestTools, n strin
 		return nil
 	}
 }
+
+func TestAccServer_ReinstallGuard(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	if !IsOfferAvailable(OfferID, Zone, tt) {
+		t.Skip("Offer is out of stock")
+	}
+
+	name := "TestAccServer_ReinstallGuard"
+	config := func(sshKeys string, reinstallOnConfigChanges bool) string {
+		return fmt.Sprintf(`
+			data "scaleway_baremetal_os" "my_os" {
+				zone    = "fr-par-1"
+				name    = "Ubuntu"
+				version = "22.04 LTS (Jammy Jellyfish)"
+			}
+
+			resource "scaleway_iam_ssh_key" "main" {
+				name       = "%[1]s"
+				public_key = "%[2]s"
+			}
+
+			resource "scaleway_iam_ssh_key" "other" {
+				name       = "%[1]s-other"
+				public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIB+VcxBZwM42mR67Ctnq4+kVxH86sSsgBx5zfk+6S1VY opensource@scaleway.com"
+			}
+
+			resource "scaleway_baremetal_server" "base" {
+				name        = "%[1]s"
+				zone        = "fr-par-1"
+				offer       = "%[3]s"
+				os          = data.scaleway_baremetal_os.my_os.os_id
+				ssh_key_ids = [ %[4]s ]
+
+				reinstall_on_config_changes = %[5]t
+			}
+		`, name, SSHKeyBaremetal, OfferName, sshKeys, reinstallOnConfigChanges)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      baremetalchecks.CheckServerDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: config("scaleway_iam_ssh_key.main.id", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaremetalServerExists(tt, "scaleway_baremetal_server.base"),
+					resource.TestCheckResourceAttr("scaleway_baremetal_server.base", "ssh_key_ids.#", "1"),
+				),
+			},
+			{
+				Config:      config("scaleway_iam_ssh_key.main.id, scaleway_iam_ssh_key.other.id", false),
+				ExpectError: regexp.MustCompile("changing ssh_key_ids reinstalls the server"),
+			},
+			{
+				Config: config("scaleway_iam_ssh_key.main.id, scaleway_iam_ssh_key.other.id", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaremetalServerExists(tt, "scaleway_baremetal_server.base"),
+					resource.TestCheckResourceAttr("scaleway_baremetal_server.base", "ssh_key_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
@@ -28,6 +28,9 @@ func testSweepServer(_ string) error {
 		}
 
 		for _, server := range listServers.Servers {
+			if !acctest.ShouldSweep("scaleway_baremetal_server", server.ID, server.Name, server.Tags) {
+				continue
+			}
 			_, err := baremetalAPI.DeleteServer(&baremetalSDK.DeleteServerRequest{
 				Zone:     zone,
 				ServerID: server.ID,
@@ -0,0 +1,40 @@
+package block
+
+import (
+	"fmt"
+
+	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+)
+
+// MoveStateFromInstanceVolume converts the state of a scaleway_instance_volume into the attributes of a
+// scaleway_block_volume. Only volumes already migrated to Block Storage, of type sbs_volume, can be moved.
+func MoveStateFromInstanceVolume(source map[string]interface{}) (map[string]interface{}, error) {
+	volumeType, _ := source["type"].(string)
+	if instanceSDK.VolumeVolumeType(volumeType) != instanceSDK.VolumeVolumeTypeSbsVolume {
+		return nil, fmt.Errorf("volumes of type %q are not Block Storage volumes and cannot be moved to scaleway_block_volume, migrate them to Block Storage first", volumeType)
+	}
+
+	return moveStateAttributes(source, "id", "name", "size_in_gb", "tags", "zone", "project_id"), nil
+}
+
+// MoveStateFromInstanceSnapshot converts the state of a scaleway_instance_snapshot into the attributes of a
+// scaleway_block_snapshot. Only snapshots already migrated to Block Storage, of type sbs_snapshot, can be moved.
+func MoveStateFromInstanceSnapshot(source map[string]interface{}) (map[string]interface{}, error) {
+	snapshotType, _ := source["type"].(string)
+	if instanceSDK.VolumeVolumeType(snapshotType) != instanceSDK.VolumeVolumeTypeSbsSnapshot {
+		return nil, fmt.Errorf("snapshots of type %q are not Block Storage snapshots and cannot be moved to scaleway_block_snapshot, migrate them to Block Storage first", snapshotType)
+	}
+
+	return moveStateAttributes(source, "id", "name", "volume_id", "tags", "zone", "project_id"), nil
+}
+
+func moveStateAttributes(source map[string]interface{}, keys ...string) map[string]interface{} {
+	attributes := make(map[string]interface{}, len(keys))
+	for _, key := range keys {
+		if value, ok := source[key]; ok && value != nil {
+			attributes[key] = value
+		}
+	}
+
+	return attributes
+}
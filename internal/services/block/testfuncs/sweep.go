@@ -34,6 +34,9 @@ func testSweepBlockVolume(_ string) error {
 		}
 
 		for _, volume := range listVolumes.Volumes {
+			if !acctest.ShouldSweep("scaleway_block_volume", volume.ID, volume.Name, volume.Tags) {
+				continue
+			}
 			err := blockAPI.DeleteVolume(&blockSDK.DeleteVolumeRequest{
 				VolumeID: volume.ID,
 				Zone:     zone,
@@ -62,6 +65,9 @@ func testSweepSnapshot(_ string) error {
 		}
 
 		for _, snapshot := range listSnapshots.Snapshots {
+			if !acctest.ShouldSweep("scaleway_block_snapshot", snapshot.ID, snapshot.Name, snapshot.Tags) {
+				continue
+			}
 			err := blockAPI.DeleteSnapshot(&blockSDK.DeleteSnapshotRequest{
 				SnapshotID: snapshot.ID,
 				Zone:       zone,
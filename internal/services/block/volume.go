@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	block "github.com/scaleway/scaleway-sdk-go/api/block/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
@@ -40,10 +41,10 @@ func ResourceVolume() *schema.Resource {
 				Description: "The volume name",
 			},
 			"iops": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "The maximum IO/s expected, must match available options",
-				ForceNew:    true,
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The maximum IO/s expected, must match available options",
+				ValidateFunc: validation.IntInSlice([]int{5000, 15000}),
 			},
 			"size_in_gb": {
 				Type:         schema.TypeInt,
@@ -190,10 +191,21 @@ func ResourceBlockVolumeUpdate(ctx context.Context, d *schema.ResourceData, m in
 		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 	}
 
+	if d.HasChange("iops") {
+		req.PerfIops = types.ExpandUint32Ptr(d.Get("iops"))
+	}
+
 	if _, err := api.UpdateVolume(req, scw.WithContext(ctx)); err != nil {
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("iops") {
+		_, err = waitForBlockVolume(ctx, api, zone, volume.ID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return ResourceBlockVolumeRead(ctx, d, m)
 }
 
@@ -2,6 +2,7 @@ package cockpittestfuncs
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -58,6 +59,9 @@ func testSweepCockpitToken(_ string) error {
 			}
 
 			for _, token := range listTokens.Tokens {
+				if !acctest.ShouldSweep("scaleway_cockpit_token", token.ID, token.Name, nil) {
+					continue
+				}
 				err = cockpitAPI.DeleteToken(&cockpit.RegionalAPIDeleteTokenRequest{
 					TokenID: token.ID,
 				})
@@ -100,6 +104,9 @@ func testSweepCockpitGrafanaUser(_ string) error {
 			}
 
 			for _, grafanaUser := range listGrafanaUsers.GrafanaUsers {
+				if !acctest.ShouldSweep("scaleway_cockpit_grafana_user", strconv.FormatUint(uint64(grafanaUser.ID), 10), grafanaUser.Login, nil) {
+					continue
+				}
 				err = cockpitAPI.DeleteGrafanaUser(&cockpit.GlobalAPIDeleteGrafanaUserRequest{
 					ProjectID:     project.ID,
 					GrafanaUserID: grafanaUser.ID,
@@ -175,6 +182,9 @@ func testSweepCockpitSource(_ string) error {
 			}
 
 			for _, datsource := range listDatasources.DataSources {
+				if !acctest.ShouldSweep("scaleway_cockpit_source", datsource.ID, datsource.Name, nil) {
+					continue
+				}
 				err = cockpitAPI.DeleteDataSource(&cockpit.RegionalAPIDeleteDataSourceRequest{
 					DataSourceID: datsource.ID,
 					Region:       region,
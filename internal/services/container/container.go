@@ -117,7 +117,7 @@ func ResourceContainer() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "The scaleway registry image address",
+				Description: "The registry image address, from a Scaleway or an external public registry",
 			},
 			"registry_sha256": {
 				Type:         schema.TypeString,
@@ -39,6 +39,9 @@ func testSweepTrigger(_ string) error {
 		}
 
 		for _, trigger := range listTriggers.Triggers {
+			if !acctest.ShouldSweep("scaleway_container_trigger", trigger.ID, trigger.Name, nil) {
+				continue
+			}
 			_, err := containerAPI.DeleteTrigger(&containerSDK.DeleteTriggerRequest{
 				TriggerID: trigger.ID,
 				Region:    region,
@@ -67,6 +70,9 @@ func testSweepContainer(_ string) error {
 		}
 
 		for _, cont := range listNamespaces.Containers {
+			if !acctest.ShouldSweep("scaleway_container", cont.ID, cont.Name, nil) {
+				continue
+			}
 			_, err := containerAPI.DeleteContainer(&containerSDK.DeleteContainerRequest{
 				ContainerID: cont.ID,
 				Region:      region,
@@ -95,6 +101,9 @@ func testSweepNamespace(_ string) error {
 		}
 
 		for _, ns := range listNamespaces.Namespaces {
+			if !acctest.ShouldSweep("scaleway_container_namespace", ns.ID, ns.Name, ns.Tags) {
+				continue
+			}
 			_, err := containerAPI.DeleteNamespace(&containerSDK.DeleteNamespaceRequest{
 				NamespaceID: ns.ID,
 				Region:      region,
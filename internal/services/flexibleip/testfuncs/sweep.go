@@ -28,6 +28,9 @@ func testSweepFlexibleIP(_ string) error {
 		}
 
 		for _, ip := range listIPs.FlexibleIPs {
+			if !acctest.ShouldSweep("scaleway_flexible_ip", ip.ID, ip.IPAddress.String(), ip.Tags) {
+				continue
+			}
 			err := fipAPI.DeleteFlexibleIP(&flexibleipSDK.DeleteFlexibleIPRequest{
 				FipID: ip.ID,
 				Zone:  zone,
@@ -2,15 +2,19 @@ package function
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	domainSDK "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
 	function "github.com/scaleway/scaleway-sdk-go/api/function/v1beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
 
@@ -45,6 +49,17 @@ func ResourceDomain() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+			"dns_zone": {
+				Type:        schema.TypeString,
+				Description: "The Scaleway DNS zone in which to create the CNAME record pointing the hostname to the function",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"dns_record_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the CNAME record created in dns_zone",
+				Computed:    true,
+			},
 			"url": {
 				Type:        schema.TypeString,
 				Description: "URL to use to trigger the function",
@@ -63,13 +78,21 @@ func ResourceFunctionDomainCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	functionID := regional.ExpandID(d.Get("function_id").(string)).ID
-	_, err = waitForFunction(ctx, api, region, functionID, d.Timeout(schema.TimeoutCreate))
+	f, err := waitForFunction(ctx, api, region, functionID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	hostname := d.Get("hostname").(string)
 
+	if dnsZone, ok := d.GetOk("dns_zone"); ok {
+		recordID, err := createFunctionDomainRecord(ctx, domainSDK.NewAPI(meta.ExtractScwClient(m)), dnsZone.(string), hostname, f.DomainName)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to create function domain record: %w", err))
+		}
+		_ = d.Set("dns_record_id", recordID)
+	}
+
 	req := &function.CreateDomainRequest{
 		Region:     region,
 		FunctionID: functionID,
@@ -78,15 +101,23 @@ func ResourceFunctionDomainCreate(ctx context.Context, d *schema.ResourceData, m
 
 	domain, err := retryCreateFunctionDomain(ctx, api, req, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
+		if dnsZone, ok := d.GetOk("dns_zone"); ok {
+			// Do not leave the record behind if the domain could not be created
+			_ = deleteFunctionDomainRecord(ctx, domainSDK.NewAPI(meta.ExtractScwClient(m)), dnsZone.(string), d.Get("dns_record_id").(string))
+		}
 		return diag.FromErr(err)
 	}
 
 	d.SetId(regional.NewIDString(region, domain.ID))
 
-	_, err = waitForDomain(ctx, api, region, domain.ID, d.Timeout(schema.TimeoutCreate))
+	// The domain is ready once its TLS certificate has been provisioned.
+	domain, err = waitForDomain(ctx, api, region, domain.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if domain.Status == function.DomainStatusError {
+		return diag.FromErr(fmt.Errorf("function domain %s is in error state: %v", hostname, types.FlattenStringPtr(domain.ErrorMessage)))
+	}
 
 	return ResourceFunctionDomainRead(ctx, d, m)
 }
@@ -142,5 +173,62 @@ func ResourceFunctionDomainDelete(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	if dnsZone, ok := d.GetOk("dns_zone"); ok {
+		err = deleteFunctionDomainRecord(ctx, domainSDK.NewAPI(meta.ExtractScwClient(m)), dnsZone.(string), d.Get("dns_record_id").(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to delete function domain record: %w", err))
+		}
+	}
+
+	return nil
+}
+
+func createFunctionDomainRecord(ctx context.Context, domainAPI *domainSDK.API, dnsZone string, hostname string, functionDomainName string) (string, error) {
+	record, err := FunctionDomainRecord(dnsZone, hostname, functionDomainName)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := domainAPI.UpdateDNSZoneRecords(&domainSDK.UpdateDNSZoneRecordsRequest{
+		DNSZone: dnsZone,
+		Changes: []*domainSDK.RecordChange{
+			{
+				Add: &domainSDK.RecordChangeAdd{
+					Records: []*domainSDK.Record{record},
+				},
+			},
+		},
+		ReturnAllRecords: scw.BoolPtr(false),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	if len(res.Records) == 0 {
+		return "", fmt.Errorf("no record created in DNS zone %s", dnsZone)
+	}
+
+	return res.Records[0].ID, nil
+}
+
+func deleteFunctionDomainRecord(ctx context.Context, domainAPI *domainSDK.API, dnsZone string, recordID string) error {
+	if recordID == "" {
+		return nil
+	}
+
+	_, err := domainAPI.UpdateDNSZoneRecords(&domainSDK.UpdateDNSZoneRecordsRequest{
+		DNSZone: dnsZone,
+		Changes: []*domainSDK.RecordChange{
+			{
+				Delete: &domainSDK.RecordChangeDelete{
+					ID: scw.StringPtr(recordID),
+				},
+			},
+		},
+		ReturnAllRecords: scw.BoolPtr(false),
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		return err
+	}
+
 	return nil
 }
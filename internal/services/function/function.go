@@ -162,7 +162,15 @@ func ResourceFunction() *schema.Resource {
 			},
 			"region":          regional.Schema(),
 			"organization_id": account.OrganizationIDSchema(),
-			"project_id":      account.ProjectIDSchema(),
+			"project_id": {
+				Type:             schema.TypeString,
+				Description:      "The project_id you want to attach the resource to",
+				Optional:         true,
+				ForceNew:         true,
+				Computed:         true,
+				ValidateDiagFunc: verify.IsUUID(),
+				Deprecated:       "The function belongs to the project of its namespace, set project_id on scaleway_function_namespace instead",
+			},
 		},
 		CustomizeDiff: cdf.LocalityCheck("namespace_id"),
 	}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	domainSDK "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
 	function "github.com/scaleway/scaleway-sdk-go/api/function/v1beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
@@ -25,6 +26,7 @@ const (
 	DefaultFunctionRetryInterval    = 5 * time.Second
 	defaultFunctionAfterUpdateWait  = 1 * time.Second
 	defaultFunctionCronTimeout      = 5 * time.Minute
+	functionDomainRecordTTL         = 300
 )
 
 // functionAPIWithRegion returns a new container registry API and the region.
@@ -169,3 +171,20 @@ func retryCreateFunctionDomain(ctx context.Context, functionAPI *function.API, r
 		}
 	}
 }
+
+// FunctionDomainRecord returns the CNAME record of the DNS zone pointing the hostname to the function domain name.
+func FunctionDomainRecord(dnsZone string, hostname string, functionDomainName string) (*domainSDK.Record, error) {
+	dnsZone = strings.TrimSuffix(dnsZone, ".")
+	hostname = strings.TrimSuffix(hostname, ".")
+
+	if !strings.HasSuffix(hostname, "."+dnsZone) {
+		return nil, fmt.Errorf("hostname %s must be a subdomain of the DNS zone %s, a CNAME record cannot be created at the zone apex", hostname, dnsZone)
+	}
+
+	return &domainSDK.Record{
+		Name: strings.TrimSuffix(hostname, "."+dnsZone),
+		Data: strings.TrimSuffix(functionDomainName, ".") + ".",
+		TTL:  functionDomainRecordTTL,
+		Type: domainSDK.RecordTypeCNAME,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package function_test
+
+import (
+	"testing"
+
+	domainSDK "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/function"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFunctionDomainRecord(t *testing.T) {
+	record, err := function.FunctionDomainRecord("example.com.", "api.example.com", "myfunc.functions.fnc.fr-par.scw.cloud")
+	require.NoError(t, err)
+	assert.Equal(t, "api", record.Name)
+	assert.Equal(t, "myfunc.functions.fnc.fr-par.scw.cloud.", record.Data)
+	assert.Equal(t, domainSDK.RecordTypeCNAME, record.Type)
+
+	_, err = function.FunctionDomainRecord("example.com", "example.com", "myfunc.functions.fnc.fr-par.scw.cloud")
+	require.Error(t, err)
+
+	_, err = function.FunctionDomainRecord("example.com", "api.example.org", "myfunc.functions.fnc.fr-par.scw.cloud")
+	require.Error(t, err)
+}
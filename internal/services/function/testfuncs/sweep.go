@@ -43,6 +43,9 @@ func testSweepFunctionTrigger(_ string) error {
 		}
 
 		for _, trigger := range listTriggers.Triggers {
+			if !acctest.ShouldSweep("scaleway_function_trigger", trigger.ID, trigger.Name, nil) {
+				continue
+			}
 			_, err := functionAPI.DeleteTrigger(&functionSDK.DeleteTriggerRequest{
 				TriggerID: trigger.ID,
 				Region:    region,
@@ -71,6 +74,9 @@ func testSweepFunctionNamespace(_ string) error {
 		}
 
 		for _, ns := range listNamespaces.Namespaces {
+			if !acctest.ShouldSweep("scaleway_function_namespace", ns.ID, ns.Name, ns.Tags) {
+				continue
+			}
 			_, err := functionAPI.DeleteNamespace(&functionSDK.DeleteNamespaceRequest{
 				NamespaceID: ns.ID,
 				Region:      region,
@@ -99,6 +105,9 @@ func testSweepFunction(_ string) error {
 		}
 
 		for _, f := range listFunctions.Functions {
+			if !acctest.ShouldSweep("scaleway_function", f.ID, f.Name, nil) {
+				continue
+			}
 			_, err := functionAPI.DeleteFunction(&functionSDK.DeleteFunctionRequest{
 				FunctionID: f.ID,
 				Region:     region,
@@ -127,6 +136,9 @@ func testSweepFunctionCron(_ string) error {
 		}
 
 		for _, cron := range listCron.Crons {
+			if !acctest.ShouldSweep("scaleway_function_cron", cron.ID, cron.Name, nil) {
+				continue
+			}
 			_, err := functionAPI.DeleteCron(&functionSDK.DeleteCronRequest{
 				CronID: cron.ID,
 				Region: region,
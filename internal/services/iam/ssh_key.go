@@ -3,6 +3,8 @@ package iam
 import (
 	"bytes"
 	"context"
+	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -12,6 +14,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 	"golang.org/x/crypto/ssh"
 )
 
@@ -79,6 +82,23 @@ func ResourceSSKKey() *schema.Resource {
 				Default:     false,
 				Description: "The SSH key status",
 			},
+			"project_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: verify.IsUUID(),
+				},
+				Description: "The projects the SSH key is propagated to, in addition to project_id",
+			},
+			"propagated_ssh_key_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The IDs of the SSH keys created in each project of project_ids, by project ID",
+			},
 		},
 	}
 }
@@ -96,8 +116,8 @@ func resourceIamSSKKeyCreate(ctx context.Context, d *schema.ResourceData, m inte
 	}
 
 	if _, disabledExists := d.GetOk("disabled"); disabledExists {
-		_, err = api.UpdateSSHKey(&iam.UpdateSSHKeyRequest{
-			SSHKeyID: d.Id(),
+		res, err = api.UpdateSSHKey(&iam.UpdateSSHKeyRequest{
+			SSHKeyID: res.ID,
 			Disabled: types.ExpandBoolPtr(types.GetBool(d, "disabled")),
 		}, scw.WithContext(ctx))
 		if err != nil {
@@ -107,6 +127,12 @@ func resourceIamSSKKeyCreate(ctx context.Context, d *schema.ResourceData, m inte
 
 	d.SetId(res.ID)
 
+	propagatedKeyIDs, err := propagateSSHKey(ctx, api, res, map[string]interface{}{}, sshKeyPropagatedProjectIDs(d))
+	_ = d.Set("propagated_ssh_key_ids", propagatedKeyIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceIamSSHKeyRead(ctx, d, m)
 }
 
@@ -133,6 +159,33 @@ func resourceIamSSHKeyRead(ctx context.Context, d *schema.ResourceData, m interf
 	_ = d.Set("project_id", res.ProjectID)
 	_ = d.Set("disabled", res.Disabled)
 
+	propagatedKeyIDs := map[string]interface{}{}
+	for projectID, keyID := range d.Get("propagated_ssh_key_ids").(map[string]interface{}) {
+		_, err := api.GetSSHKey(&iam.GetSSHKeyRequest{
+			SSHKeyID: keyID.(string),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			if httperrors.Is404(err) {
+				// The key is created again on next apply.
+				continue
+			}
+			return diag.FromErr(err)
+		}
+		propagatedKeyIDs[projectID] = keyID
+	}
+
+	projectIDs := []string(nil)
+	for projectID := range propagatedKeyIDs {
+		projectIDs = append(projectIDs, projectID)
+	}
+	// The own project of the key is kept if listed as it is not propagated.
+	if d.Get("project_ids").(*schema.Set).Contains(res.ProjectID) {
+		projectIDs = append(projectIDs, res.ProjectID)
+	}
+
+	_ = d.Set("propagated_ssh_key_ids", propagatedKeyIDs)
+	_ = d.Set("project_ids", projectIDs)
+
 	return nil
 }
 
@@ -177,12 +230,67 @@ func resourceIamSSKKeyUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		}
 	}
 
+	propagatedKeyIDs := d.Get("propagated_ssh_key_ids").(map[string]interface{})
+
+	if d.HasChanges("name", "disabled") {
+		for _, keyID := range propagatedKeyIDs {
+			_, err := api.UpdateSSHKey(&iam.UpdateSSHKeyRequest{
+				SSHKeyID: keyID.(string),
+				Name:     types.ExpandStringPtr(d.Get("name")),
+				Disabled: types.ExpandBoolPtr(d.Get("disabled")),
+			}, scw.WithContext(ctx))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("project_ids") {
+		projectIDs := sshKeyPropagatedProjectIDs(d)
+
+		for projectID, keyID := range propagatedKeyIDs {
+			if slices.Contains(projectIDs, projectID) {
+				continue
+			}
+
+			err := api.DeleteSSHKey(&iam.DeleteSSHKeyRequest{
+				SSHKeyID: keyID.(string),
+			}, scw.WithContext(ctx))
+			if err != nil && !httperrors.Is404(err) {
+				return diag.FromErr(err)
+			}
+			delete(propagatedKeyIDs, projectID)
+		}
+
+		res, err := api.GetSSHKey(&iam.GetSSHKeyRequest{
+			SSHKeyID: d.Id(),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		propagatedKeyIDs, err = propagateSSHKey(ctx, api, res, propagatedKeyIDs, projectIDs)
+		_ = d.Set("propagated_ssh_key_ids", propagatedKeyIDs)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIamSSHKeyRead(ctx, d, m)
 }
 
 func resourceIamSSKKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := NewAPI(m)
 
+	for _, keyID := range d.Get("propagated_ssh_key_ids").(map[string]interface{}) {
+		err := api.DeleteSSHKey(&iam.DeleteSSHKeyRequest{
+			SSHKeyID: keyID.(string),
+		}, scw.WithContext(ctx))
+		if err != nil && !httperrors.Is404(err) {
+			return diag.FromErr(err)
+		}
+	}
+
 	err := api.DeleteSSHKey(&iam.DeleteSSHKeyRequest{
 		SSHKeyID: d.Id(),
 	}, scw.WithContext(ctx))
@@ -192,3 +300,48 @@ func resourceIamSSKKeyDelete(ctx context.Context, d *schema.ResourceData, m inte
 
 	return nil
 }
+
+// sshKeyPropagatedProjectIDs returns the projects the SSH key must be propagated to, excluding its own project.
+func sshKeyPropagatedProjectIDs(d *schema.ResourceData) []string {
+	projectIDs := []string(nil)
+	for _, projectID := range types.ExpandStrings(d.Get("project_ids").(*schema.Set).List()) {
+		if projectID != d.Get("project_id").(string) {
+			projectIDs = append(projectIDs, projectID)
+		}
+	}
+
+	return projectIDs
+}
+
+// propagateSSHKey creates a copy of the SSH key in each given project missing from propagatedKeyIDs.
+// The returned map includes the keys created before an error.
+func propagateSSHKey(ctx context.Context, api *iam.API, sshKey *iam.SSHKey, propagatedKeyIDs map[string]interface{}, projectIDs []string) (map[string]interface{}, error) {
+	for _, projectID := range projectIDs {
+		if _, exists := propagatedKeyIDs[projectID]; exists {
+			continue
+		}
+
+		res, err := api.CreateSSHKey(&iam.CreateSSHKeyRequest{
+			Name:      sshKey.Name,
+			PublicKey: sshKey.PublicKey,
+			ProjectID: projectID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return propagatedKeyIDs, fmt.Errorf("failed to propagate SSH key to project %s: %w", projectID, err)
+		}
+
+		if sshKey.Disabled {
+			_, err = api.UpdateSSHKey(&iam.UpdateSSHKeyRequest{
+				SSHKeyID: res.ID,
+				Disabled: scw.BoolPtr(true),
+			}, scw.WithContext(ctx))
+			if err != nil {
+				return propagatedKeyIDs, err
+			}
+		}
+
+		propagatedKeyIDs[projectID] = res.ID
+	}
+
+	return propagatedKeyIDs, nil
+}
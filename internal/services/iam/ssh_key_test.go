@@ -2,10 +2,14 @@ package iam_test
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	iamSDK "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/iam"
 	iamchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/iam/testfuncs"
 )
 
@@ -178,3 +182,105 @@ func TestAccSSHKey_Disabled(t *testing.T) {
 		},
 	})
 }
+
+func TestAccSSHKey_PropagatedToProjects(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	config := func(name string, projects string, disabled bool) string {
+		return fmt.Sprintf(`
+			resource "scaleway_account_project" "main" {
+				name = "tf-tests-iam-ssh-key-propagated-main"
+			}
+
+			resource "scaleway_account_project" "second" {
+				name = "tf-tests-iam-ssh-key-propagated-second"
+			}
+
+			resource "scaleway_account_project" "third" {
+				name = "tf-tests-iam-ssh-key-propagated-third"
+			}
+
+			resource "scaleway_iam_ssh_key" "main" {
+				name        = "%s"
+				public_key  = "%s"
+				project_id  = scaleway_account_project.main.id
+				project_ids = [%s]
+				disabled    = %t
+			}
+		`, name, SSHKey, projects, disabled)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      iamchecks.CheckSSHKeyDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				// The key is not copied in its own project
+				Config: config("tf-tests-iam-ssh-key-propagated", "scaleway_account_project.main.id, scaleway_account_project.second.id", false),
+				Check: resource.ComposeTestCheckFunc(
+					iamchecks.CheckSSHKeyExists(tt, "scaleway_iam_ssh_key.main"),
+					resource.TestCheckResourceAttr("scaleway_iam_ssh_key.main", "propagated_ssh_key_ids.%", "1"),
+					isSSHKeyPropagated(tt, "scaleway_iam_ssh_key.main", "scaleway_account_project.second"),
+				),
+			},
+			{
+				// Copies follow the name of the key, and are created or deleted with the projects
+				Config: config("tf-tests-iam-ssh-key-propagated-renamed", "scaleway_account_project.third.id", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_iam_ssh_key.main", "propagated_ssh_key_ids.%", "1"),
+					isSSHKeyPropagated(tt, "scaleway_iam_ssh_key.main", "scaleway_account_project.third"),
+				),
+			},
+			{
+				Config: config("tf-tests-iam-ssh-key-propagated-renamed", "scaleway_account_project.third.id", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_iam_ssh_key.main", "disabled", "true"),
+					isSSHKeyPropagated(tt, "scaleway_iam_ssh_key.main", "scaleway_account_project.third"),
+				),
+			},
+		},
+	})
+}
+
+// isSSHKeyPropagated checks the copy of the key in the given project has the same name and status
+func isSSHKeyPropagated(tt *acctest.TestTools, keyResource string, projectResource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		key, ok := state.RootModule().Resources[keyResource]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", keyResource)
+		}
+		project, ok := state.RootModule().Resources[projectResource]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", projectResource)
+		}
+
+		copyID, ok := key.Primary.Attributes["propagated_ssh_key_ids."+project.Primary.ID]
+		if !ok {
+			return fmt.Errorf("SSH key %s is not propagated to project %s", key.Primary.ID, project.Primary.ID)
+		}
+
+		sshKeyCopy, err := iam.NewAPI(tt.Meta).GetSSHKey(&iamSDK.GetSSHKeyRequest{
+			SSHKeyID: copyID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if sshKeyCopy.ProjectID != project.Primary.ID {
+			return fmt.Errorf("SSH key copy %s is in project %s, expected %s", copyID, sshKeyCopy.ProjectID, project.Primary.ID)
+		}
+		if sshKeyCopy.Name != key.Primary.Attributes["name"] {
+			return fmt.Errorf("SSH key copy %s is named %s, expected %s", copyID, sshKeyCopy.Name, key.Primary.Attributes["name"])
+		}
+		if strconv.FormatBool(sshKeyCopy.Disabled) != key.Primary.Attributes["disabled"] {
+			return fmt.Errorf("SSH key copy %s has disabled %t, expected %s", copyID, sshKeyCopy.Disabled, key.Primary.Attributes["disabled"])
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,158 @@
+package iam
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func DataSourceSSHKeys() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceIamSSHKeysRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "SSH keys with a name like it are listed.",
+			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "SSH keys with this exact fingerprint are listed.",
+			},
+			"project_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "SSH keys of this project are listed, all the keys of the organization are listed by default.",
+				ValidateDiagFunc: verify.IsUUID(),
+			},
+			"include_disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "List the disabled SSH keys too.",
+			},
+			"ssh_keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"name": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"public_key": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"fingerprint": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"disabled": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+						"created_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"updated_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"organization_id": account.OrganizationIDSchema(),
+						"project_id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+					},
+				},
+			},
+			"public_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The public keys of the listed SSH keys",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"organization_id": account.OrganizationIDOptionalSchema(),
+		},
+	}
+}
+
+func DataSourceIamSSHKeysRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	iamAPI := NewAPI(m)
+
+	req := &iam.ListSSHKeysRequest{
+		OrganizationID: account.GetOrganizationID(m, d),
+		Name:           types.ExpandStringPtr(d.Get("name")),
+		ProjectID:      types.ExpandStringPtr(d.Get("project_id")),
+	}
+	if !d.Get("include_disabled").(bool) {
+		req.Disabled = scw.BoolPtr(false)
+	}
+
+	res, err := iamAPI.ListSSHKeys(req, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fingerprint := d.Get("fingerprint").(string)
+
+	sshKeys := []interface{}(nil)
+	publicKeys := []string(nil)
+	for _, sshKey := range res.SSHKeys {
+		// The fingerprint is prefixed by the key size and suffixed by its type, e.g. "256 SHA256:... (ED25519)"
+		if fingerprint != "" && sshKey.Fingerprint != fingerprint && !strings.Contains(sshKey.Fingerprint, " "+fingerprint+" ") {
+			continue
+		}
+
+		sshKeys = append(sshKeys, map[string]interface{}{
+			"id":              sshKey.ID,
+			"name":            sshKey.Name,
+			"public_key":      sshKey.PublicKey,
+			"fingerprint":     sshKey.Fingerprint,
+			"disabled":        sshKey.Disabled,
+			"created_at":      types.FlattenTime(sshKey.CreatedAt),
+			"updated_at":      types.FlattenTime(sshKey.UpdatedAt),
+			"organization_id": sshKey.OrganizationID,
+			"project_id":      sshKey.ProjectID,
+		})
+		publicKeys = append(publicKeys, sshKey.PublicKey)
+	}
+
+	orgID := types.FlattenStringPtr(req.OrganizationID).(string)
+	if orgID != "" {
+		d.SetId(orgID)
+	} else {
+		// The organization is not known when the credentials are only scoped to a project
+		filters := fmt.Sprintf("%s-%s-%s-%t",
+			d.Get("name").(string),
+			d.Get("project_id").(string),
+			fingerprint,
+			d.Get("include_disabled").(bool))
+		hashedFilters := sha256.Sum256([]byte(filters))
+		d.SetId(hex.EncodeToString(hashedFilters[:]))
+	}
+	_ = d.Set("organization_id", orgID)
+	_ = d.Set("ssh_keys", sshKeys)
+	_ = d.Set("public_keys", publicKeys)
+
+	return nil
+}
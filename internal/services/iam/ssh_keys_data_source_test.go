@@ -0,0 +1,89 @@
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	iamchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/iam/testfuncs"
+)
+
+func TestAccDataSourceSSHKeys_Basic(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      iamchecks.CheckSSHKeyDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_account_project" "main" {
+						name = "tf-tests-ds-iam-ssh-keys-basic"
+					}
+
+					resource "scaleway_iam_ssh_key" "first" {
+						name       = "tf-tests-ds-iam-ssh-keys-basic-first"
+						public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAILHy/M5FVm5ydLGcal3e5LNcfTalbeN7QL/ZGCvDEdqJ foobar@example.com"
+						project_id = scaleway_account_project.main.id
+					}
+
+					resource "scaleway_iam_ssh_key" "second" {
+						name       = "tf-tests-ds-iam-ssh-keys-basic-second"
+						public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIB+VcxBZwM42mR67Ctnq4+kVxH86sSsgBx5zfk+6S1VY opensource@scaleway.com"
+						project_id = scaleway_account_project.main.id
+					}
+				`,
+			},
+			{
+				Config: `
+					resource "scaleway_account_project" "main" {
+						name = "tf-tests-ds-iam-ssh-keys-basic"
+					}
+
+					resource "scaleway_iam_ssh_key" "first" {
+						name       = "tf-tests-ds-iam-ssh-keys-basic-first"
+						public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAILHy/M5FVm5ydLGcal3e5LNcfTalbeN7QL/ZGCvDEdqJ foobar@example.com"
+						project_id = scaleway_account_project.main.id
+					}
+
+					resource "scaleway_iam_ssh_key" "second" {
+						name       = "tf-tests-ds-iam-ssh-keys-basic-second"
+						public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIB+VcxBZwM42mR67Ctnq4+kVxH86sSsgBx5zfk+6S1VY opensource@scaleway.com"
+						project_id = scaleway_account_project.main.id
+					}
+
+					data "scaleway_account_project" "by_name" {
+						name = scaleway_account_project.main.name
+					}
+
+					data "scaleway_iam_ssh_keys" "project" {
+						project_id = data.scaleway_account_project.by_name.id
+					}
+
+					data "scaleway_iam_ssh_keys" "by_name" {
+						name       = "basic-second"
+						project_id = data.scaleway_account_project.by_name.id
+					}
+
+					data "scaleway_iam_ssh_keys" "by_fingerprint" {
+						fingerprint = scaleway_iam_ssh_key.first.fingerprint
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.scaleway_account_project.by_name", "id", "scaleway_account_project.main", "id"),
+					resource.TestCheckResourceAttr("data.scaleway_iam_ssh_keys.project", "ssh_keys.#", "2"),
+					resource.TestCheckResourceAttr("data.scaleway_iam_ssh_keys.project", "public_keys.#", "2"),
+					resource.TestCheckResourceAttr("data.scaleway_iam_ssh_keys.by_name", "ssh_keys.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_iam_ssh_keys.by_name", "ssh_keys.0.id", "scaleway_iam_ssh_key.second", "id"),
+					resource.TestCheckResourceAttrPair("data.scaleway_iam_ssh_keys.by_name", "public_keys.0", "scaleway_iam_ssh_key.second", "public_key"),
+					resource.TestCheckResourceAttr("data.scaleway_iam_ssh_keys.by_fingerprint", "ssh_keys.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_iam_ssh_keys.by_fingerprint", "ssh_keys.0.id", "scaleway_iam_ssh_key.first", "id"),
+				),
+			},
+		},
+	})
+}
@@ -54,6 +54,9 @@ func testSweepUser(_ string) error {
 			return fmt.Errorf("failed to list users: %w", err)
 		}
 		for _, user := range listUsers.Users {
+			if !acctest.ShouldSweep("scaleway_iam_user", user.ID, user.Email, user.Tags) {
+				continue
+			}
 			if !acctest.IsTestResource(user.Email) {
 				continue
 			}
@@ -80,6 +83,9 @@ func testSweepSSHKey(_ string) error {
 		}
 
 		for _, sshKey := range listSSHKeys.SSHKeys {
+			if !acctest.ShouldSweep("scaleway_iam_ssh_key", sshKey.ID, sshKey.Name, nil) {
+				continue
+			}
 			if !acctest.IsTestResource(sshKey.Name) {
 				continue
 			}
@@ -111,6 +117,9 @@ func testSweepIamPolicy(_ string) error {
 			return fmt.Errorf("failed to list policies: %w", err)
 		}
 		for _, pol := range listPols.Policies {
+			if !acctest.ShouldSweep("scaleway_iam_policy", pol.ID, pol.Name, pol.Tags) {
+				continue
+			}
 			if !acctest.IsTestResource(pol.Name) {
 				continue
 			}
@@ -141,6 +150,9 @@ func testSweepIamGroup(_ string) error {
 			return fmt.Errorf("failed to list groups: %w", err)
 		}
 		for _, group := range listApps.Groups {
+			if !acctest.ShouldSweep("scaleway_iam_group", group.ID, group.Name, group.Tags) {
+				continue
+			}
 			if !acctest.IsTestResource(group.Name) {
 				continue
 			}
@@ -171,6 +183,9 @@ func testSweepIamApplication(_ string) error {
 			return fmt.Errorf("failed to list applications: %w", err)
 		}
 		for _, app := range listApps.Applications {
+			if !acctest.ShouldSweep("scaleway_iam_application", app.ID, app.Name, app.Tags) {
+				continue
+			}
 			if !acctest.IsTestResource(app.Name) {
 				continue
 			}
@@ -204,6 +219,9 @@ func testSweepIamAPIKey(_ string) error {
 			return fmt.Errorf("failed to list api keys: %w", err)
 		}
 		for _, key := range listAPIKeys.APIKeys {
+			if !acctest.ShouldSweep("scaleway_iam_api_key", key.AccessKey, key.Description, nil) {
+				continue
+			}
 			if !acctest.IsTestResource(key.Description) {
 				continue
 			}
@@ -0,0 +1,237 @@
+package inference
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	inference "github.com/scaleway/scaleway-sdk-go/api/inference/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func ResourceDeploymentACL() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceDeploymentACLCreate,
+		ReadContext:   ResourceDeploymentACLRead,
+		UpdateContext: ResourceDeploymentACLUpdate,
+		DeleteContext: ResourceDeploymentACLDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultInferenceDeploymentTimeout),
+			Read:    schema.DefaultTimeout(defaultInferenceDeploymentTimeout),
+			Update:  schema.DefaultTimeout(defaultInferenceDeploymentTimeout),
+			Delete:  schema.DefaultTimeout(defaultInferenceDeploymentTimeout),
+			Default: schema.DefaultTimeout(defaultInferenceDeploymentTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithRegion(),
+				Description:      "The ID of the deployment on which the ACL is applied",
+			},
+			"acl_rules": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "List of ACL rules allowing access to the public endpoint of the deployment",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsCIDRNetwork(0, 128),
+							Description:  "The IP range allowed to access the deployment, in CIDR notation",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The description of the rule",
+						},
+					},
+				},
+			},
+			"region": regional.Schema(),
+		},
+		CustomizeDiff: cdf.LocalityCheck("deployment_id"),
+	}
+}
+
+func ResourceDeploymentACLCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, err := NewAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentID := locality.ExpandID(d.Get("deployment_id"))
+
+	_, err = waitForDeployment(ctx, api, region, deploymentID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rules, err := expandDeploymentACLRules(d.Get("acl_rules"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = api.SetDeploymentACLRules(&inference.SetDeploymentACLRulesRequest{
+		Region:       region,
+		DeploymentID: deploymentID,
+		ACLs:         rules,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(regional.NewIDString(region, deploymentID))
+
+	return ResourceDeploymentACLRead(ctx, d, m)
+}
+
+func ResourceDeploymentACLRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, deploymentID, err := NewAPIWithRegionAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := api.ListDeploymentACLRules(&inference.ListDeploymentACLRulesRequest{
+		Region:       region,
+		DeploymentID: deploymentID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		if httperrors.Is404(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("deployment_id", d.Id())
+	_ = d.Set("acl_rules", flattenDeploymentACLRules(res.Rules, d.Get("acl_rules")))
+	_ = d.Set("region", region)
+
+	return nil
+}
+
+func ResourceDeploymentACLUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, deploymentID, err := NewAPIWithRegionAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("acl_rules") {
+		_, err = waitForDeployment(ctx, api, region, deploymentID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		rules, err := expandDeploymentACLRules(d.Get("acl_rules"))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = api.SetDeploymentACLRules(&inference.SetDeploymentACLRulesRequest{
+			Region:       region,
+			DeploymentID: deploymentID,
+			ACLs:         rules,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ResourceDeploymentACLRead(ctx, d, m)
+}
+
+func ResourceDeploymentACLDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, deploymentID, err := NewAPIWithRegionAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = waitForDeployment(ctx, api, region, deploymentID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		if httperrors.Is404(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	_, err = api.SetDeploymentACLRules(&inference.SetDeploymentACLRulesRequest{
+		Region:       region,
+		DeploymentID: deploymentID,
+		ACLs:         []*inference.ACLRuleRequest{},
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandDeploymentACLRules(raw interface{}) ([]*inference.ACLRuleRequest, error) {
+	rules := make([]*inference.ACLRuleRequest, 0, len(raw.([]interface{})))
+	for _, rawRule := range raw.([]interface{}) {
+		rule := rawRule.(map[string]interface{})
+		ip, err := types.ExpandIPNet(rule["ip"].(string))
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, &inference.ACLRuleRequest{
+			IP:          ip,
+			Description: rule["description"].(string),
+		})
+	}
+
+	return rules, nil
+}
+
+// flattenDeploymentACLRules flattens the rules in the order they are defined in the state, rules unknown to the state are appended
+func flattenDeploymentACLRules(rules []*inference.ACLRule, rawStateRules interface{}) []map[string]interface{} {
+	rulesByIP := make(map[string]*inference.ACLRule, len(rules))
+	for _, rule := range rules {
+		rulesByIP[rule.IP.String()] = rule
+	}
+
+	res := make([]map[string]interface{}, 0, len(rules))
+	flattened := make(map[string]bool, len(rules))
+	for _, rawStateRule := range rawStateRules.([]interface{}) {
+		ip, err := types.ExpandIPNet(rawStateRule.(map[string]interface{})["ip"].(string))
+		if err != nil {
+			continue
+		}
+		rule, exists := rulesByIP[ip.String()]
+		if !exists || flattened[ip.String()] {
+			continue
+		}
+		flattened[ip.String()] = true
+		res = append(res, map[string]interface{}{
+			"ip":          rule.IP.String(),
+			"description": rule.Description,
+		})
+	}
+
+	for _, rule := range rules {
+		if flattened[rule.IP.String()] {
+			continue
+		}
+		res = append(res, map[string]interface{}{
+			"ip":          rule.IP.String(),
+			"description": rule.Description,
+		})
+	}
+
+	return res
+}
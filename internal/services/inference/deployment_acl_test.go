@@ -0,0 +1,94 @@
+package inference_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/inference"
+	inferencetestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/inference/testfuncs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeploymentACL_IP(t *testing.T) {
+	tests := []struct {
+		name    string
+		ip      string
+		wantErr bool
+	}{
+		{name: "ipv4 host", ip: "1.2.3.4/32"},
+		{name: "ipv4 network", ip: "10.0.0.0/24"},
+		{name: "ipv6 network", ip: "2001:db8::/32"},
+		{name: "address inside the network", ip: "10.0.0.1/24", wantErr: true},
+		{name: "missing prefix", ip: "10.0.0.1", wantErr: true},
+	}
+
+	r := inference.ResourceDeploymentACL()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+				"deployment_id": "fr-par/11111111-1111-1111-1111-111111111111",
+				"acl_rules": []interface{}{
+					map[string]interface{}{"ip": tt.ip},
+				},
+			}))
+			assert.Equal(t, tt.wantErr, diags.HasError(), diags)
+		})
+	}
+}
+
+func TestAccDeploymentACL_Basic(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      inferencetestfuncs.IsDeploymentDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_inference_deployment" "main" {
+						name = "test-inference-deployment-acl"
+						node_type = "L4"
+						model_name = "meta/llama-3.1-8b-instruct:fp8"
+						public_endpoint {
+							is_enabled = true
+						}
+						accept_eula = true
+					}
+
+					resource "scaleway_inference_deployment_acl" "main" {
+						deployment_id = scaleway_inference_deployment.main.id
+
+						acl_rules {
+							ip          = "1.2.3.4/32"
+							description = "office"
+						}
+
+						acl_rules {
+							ip = "5.6.7.0/24"
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(tt, "scaleway_inference_deployment.main"),
+					resource.TestCheckResourceAttrPair("scaleway_inference_deployment_acl.main", "deployment_id", "scaleway_inference_deployment.main", "id"),
+					resource.TestCheckResourceAttr("scaleway_inference_deployment_acl.main", "acl_rules.#", "2"),
+					resource.TestCheckResourceAttr("scaleway_inference_deployment_acl.main", "acl_rules.0.ip", "1.2.3.4/32"),
+					resource.TestCheckResourceAttr("scaleway_inference_deployment_acl.main", "acl_rules.0.description", "office"),
+					resource.TestCheckResourceAttr("scaleway_inference_deployment_acl.main", "acl_rules.1.ip", "5.6.7.0/24"),
+				),
+			},
+			{
+				ResourceName:      "scaleway_inference_deployment_acl.main",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
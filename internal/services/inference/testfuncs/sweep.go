@@ -31,6 +31,9 @@ func testSweepDeployment(_ string) error {
 		}
 
 		for _, deployment := range listDeployments.Deployments {
+			if !acctest.ShouldSweep("scaleway_inference_deployment", deployment.ID, deployment.Name, deployment.Tags) {
+				continue
+			}
 			_, err := inferenceAPI.DeleteDeployment(&inference.DeleteDeploymentRequest{
 				DeploymentID: deployment.ID,
 				Region:       region,
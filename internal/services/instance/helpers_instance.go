@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -19,6 +20,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/mutexkv"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/block"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
@@ -32,6 +34,9 @@ const (
 	// InstanceServerStateStandby transient state of the instance event waiting third action or rescue mode
 	InstanceServerStateStandby = "standby"
 
+	// instanceServerStateDetailBooted is the state detail reported by the server, through the metadata API, once booted
+	instanceServerStateDetailBooted = "booted"
+
 	DefaultInstanceServerWaitTimeout        = 10 * time.Minute
 	defaultInstancePrivateNICWaitTimeout    = 10 * time.Minute
 	defaultInstanceVolumeDeleteTimeout      = 10 * time.Minute
@@ -47,6 +52,39 @@ const (
 	defaultInstanceImageTimeout = 1 * time.Hour
 )
 
+// serverMutexKV serializes the changes made to a server by different resources (private NICs, user data, ...) during an apply.
+// The API rejects most actions while the server is in a transient state.
+var serverMutexKV = mutexkv.NewMutexKV()
+
+// lockServer locks the given server until the returned function is called
+func lockServer(zone scw.Zone, serverID string) func() {
+	key := zonal.NewIDString(zone, serverID)
+	serverMutexKV.Lock(key)
+
+	return func() {
+		serverMutexKV.Unlock(key)
+	}
+}
+
+// lockServers locks the given servers until the returned function is called.
+// They are always locked in the same order, so that two resources locking the same servers cannot wait for each other.
+func lockServers(zone scw.Zone, serverIDs ...string) func() {
+	serverIDs = slices.Clone(serverIDs)
+	slices.Sort(serverIDs)
+	serverIDs = slices.Compact(serverIDs)
+
+	unlocks := make([]func(), 0, len(serverIDs))
+	for _, serverID := range serverIDs {
+		unlocks = append(unlocks, lockServer(zone, serverID))
+	}
+
+	return func() {
+		for _, unlock := range unlocks {
+			unlock()
+		}
+	}
+}
+
 // newAPIWithZone returns a new instance API and the zone for a Create request
 func newAPIWithZone(d *schema.ResourceData, m interface{}) (*instance.API, scw.Zone, error) {
 	instanceAPI := instance.NewAPI(meta.ExtractScwClient(m))
@@ -140,7 +178,7 @@ func serverStateExpand(rawState string) (instance.ServerState, error) {
 	return apiState, nil
 }
 
-func reachState(ctx context.Context, api *BlockAndInstanceAPI, zone scw.Zone, serverID string, toState instance.ServerState) error {
+func reachState(ctx context.Context, api *BlockAndInstanceAPI, zone scw.Zone, serverID string, toState instance.ServerState, timeout time.Duration) error {
 	response, err := api.GetServer(&instance.GetServerRequest{
 		Zone:     zone,
 		ServerID: serverID,
@@ -175,7 +213,8 @@ func reachState(ctx context.Context, api *BlockAndInstanceAPI, zone scw.Zone, se
 				VolumeID:      volume.ID,
 				Zone:          zone,
 				RetryInterval: transport.DefaultWaitRetryInterval,
-			})
+				Timeout:       scw.TimeDurationPtr(timeout),
+			}, scw.WithContext(ctx))
 			if err != nil {
 				return err
 			}
@@ -184,7 +223,8 @@ func reachState(ctx context.Context, api *BlockAndInstanceAPI, zone scw.Zone, se
 				Zone:          zone,
 				VolumeID:      volume.ID,
 				RetryInterval: transport.DefaultWaitRetryInterval,
-			})
+				Timeout:       scw.TimeDurationPtr(timeout),
+			}, scw.WithContext(ctx))
 			if err != nil {
 				return err
 			}
@@ -196,9 +236,9 @@ func reachState(ctx context.Context, api *BlockAndInstanceAPI, zone scw.Zone, se
 			ServerID:      serverID,
 			Action:        a,
 			Zone:          zone,
-			Timeout:       scw.TimeDurationPtr(DefaultInstanceServerWaitTimeout),
+			Timeout:       scw.TimeDurationPtr(timeout),
 			RetryInterval: transport.DefaultWaitRetryInterval,
-		})
+		}, scw.WithContext(ctx))
 		if err != nil {
 			return err
 		}
@@ -254,6 +294,34 @@ func validateLocalVolumeSizes(volumes map[string]*instance.VolumeServerTemplate,
 	return nil
 }
 
+// validateScratchVolumes validates that scratch volumes are supported by the server type and fit in its scratch storage.
+func validateScratchVolumes(volumes []*UnknownVolume, serverType *instance.ServerType, commercialType string) error {
+	var scratchTotalSize scw.Size
+	hasScratch := false
+	for _, volume := range volumes {
+		if volume.IsScratch() {
+			hasScratch = true
+			if volume.Size != nil {
+				scratchTotalSize += *volume.Size
+			}
+		}
+	}
+
+	if !hasScratch {
+		return nil
+	}
+
+	if serverType.ScratchStorageMaxSize == nil || *serverType.ScratchStorageMaxSize == 0 {
+		return fmt.Errorf("%s does not support scratch volumes", commercialType)
+	}
+
+	if scratchTotalSize > *serverType.ScratchStorageMaxSize {
+		return fmt.Errorf("%s total scratch volume size must not exceed %s", commercialType, humanize.Bytes(uint64(*serverType.ScratchStorageMaxSize)))
+	}
+
+	return nil
+}
+
 func preparePrivateNIC(
 	ctx context.Context, data interface{},
 	server *instance.Server, vpcAPI *vpc.API,
@@ -470,15 +538,11 @@ func instanceIPHasMigrated(d *schema.ResourceData) bool {
 	return false
 }
 
-func instanceServerAdditionalVolumeTemplate(api *BlockAndInstanceAPI, zone scw.Zone, volumeID string) (*instance.VolumeServerTemplate, error) {
-	vol, err := api.GetUnknownVolume(&GetUnknownVolumeRequest{
+func instanceServerAdditionalVolume(api *BlockAndInstanceAPI, zone scw.Zone, volumeID string) (*UnknownVolume, error) {
+	return api.GetUnknownVolume(&GetUnknownVolumeRequest{
 		VolumeID: locality.ExpandID(volumeID),
 		Zone:     zone,
 	})
-	if err != nil {
-		return nil, err
-	}
-	return vol.VolumeTemplate(), nil
 }
 
 func prepareRootVolume(rootVolumeI map[string]any, serverType *instance.ServerType, image string) *UnknownVolume {
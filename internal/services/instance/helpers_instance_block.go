@@ -78,6 +78,11 @@ func (volume *UnknownVolume) IsLocal() bool {
 	return !volume.IsBlockVolume() && volume.InstanceVolumeType == instance.VolumeVolumeTypeLSSD
 }
 
+// IsScratch returns true if the volume is a local NVMe scratch volume
+func (volume *UnknownVolume) IsScratch() bool {
+	return !volume.IsBlockVolume() && volume.InstanceVolumeType == instance.VolumeVolumeTypeScratch
+}
+
 // IsBlockVolume is true if volume is managed by block API
 func (volume *UnknownVolume) IsBlockVolume() bool {
 	return volume.InstanceVolumeType == instance.VolumeVolumeTypeSbsVolume
@@ -241,6 +246,35 @@ func (api *BlockAndInstanceAPI) GetUnknownSnapshot(req *GetUnknownSnapshotReques
 	return snap, nil
 }
 
+type DeleteUnknownSnapshotRequest struct {
+	Zone       scw.Zone
+	SnapshotID string
+}
+
+func (api *BlockAndInstanceAPI) DeleteUnknownSnapshot(req *DeleteUnknownSnapshotRequest, opts ...scw.RequestOption) error {
+	unknownSnapshot, err := api.GetUnknownSnapshot(&GetUnknownSnapshotRequest{
+		Zone:       req.Zone,
+		SnapshotID: req.SnapshotID,
+	}, opts...)
+	if err != nil {
+		return err
+	}
+
+	if unknownSnapshot.VolumeType == instance.VolumeVolumeTypeSbsSnapshot {
+		err = api.blockAPI.DeleteSnapshot(&block.DeleteSnapshotRequest{
+			Zone:       req.Zone,
+			SnapshotID: req.SnapshotID,
+		}, opts...)
+	} else {
+		err = api.API.DeleteSnapshot(&instance.DeleteSnapshotRequest{
+			Zone:       req.Zone,
+			SnapshotID: req.SnapshotID,
+		}, opts...)
+	}
+
+	return err
+}
+
 func NewBlockAndInstanceAPI(client *scw.Client) *BlockAndInstanceAPI {
 	instanceAPI := instance.NewAPI(client)
 	blockAPI := block.NewAPI(client)
@@ -65,3 +65,32 @@ func TestUnknownVolume_VolumeTemplate(t *testing.T) {
 		})
 	}
 }
+
+func TestUnknownVolume_IsScratch(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume *instance.UnknownVolume
+		want   bool
+	}{
+		{
+			name:   "Scratch",
+			volume: &instance.UnknownVolume{InstanceVolumeType: instanceSDK.VolumeVolumeTypeScratch},
+			want:   true,
+		},
+		{
+			name:   "Local",
+			volume: &instance.UnknownVolume{InstanceVolumeType: instanceSDK.VolumeVolumeTypeLSSD},
+			want:   false,
+		},
+		{
+			name:   "Block",
+			volume: &instance.UnknownVolume{InstanceVolumeType: instanceSDK.VolumeVolumeTypeSbsVolume},
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.volume.IsScratch())
+		})
+	}
+}
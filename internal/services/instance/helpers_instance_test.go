@@ -0,0 +1,65 @@
+package instance
+
+import (
+	"testing"
+
+	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateScratchVolumes(t *testing.T) {
+	scratchServerType := &instanceSDK.ServerType{ScratchStorageMaxSize: scw.SizePtr(1600 * scw.GB)}
+
+	tests := []struct {
+		name        string
+		volumes     []*UnknownVolume
+		serverType  *instanceSDK.ServerType
+		expectedErr string
+	}{
+		{
+			name: "No scratch volume",
+			volumes: []*UnknownVolume{
+				{InstanceVolumeType: instanceSDK.VolumeVolumeTypeLSSD, Size: scw.SizePtr(20 * scw.GB)},
+				{InstanceVolumeType: instanceSDK.VolumeVolumeTypeSbsVolume, Size: scw.SizePtr(50 * scw.GB)},
+			},
+			serverType: &instanceSDK.ServerType{},
+		},
+		{
+			name: "Scratch volume on a type without scratch storage",
+			volumes: []*UnknownVolume{
+				{InstanceVolumeType: instanceSDK.VolumeVolumeTypeScratch, Size: scw.SizePtr(800 * scw.GB)},
+			},
+			serverType:  &instanceSDK.ServerType{ScratchStorageMaxSize: scw.SizePtr(0)},
+			expectedErr: "PRO2-XXS does not support scratch volumes",
+		},
+		{
+			name: "Scratch volumes within the scratch storage",
+			volumes: []*UnknownVolume{
+				{InstanceVolumeType: instanceSDK.VolumeVolumeTypeScratch, Size: scw.SizePtr(800 * scw.GB)},
+				{InstanceVolumeType: instanceSDK.VolumeVolumeTypeScratch, Size: scw.SizePtr(800 * scw.GB)},
+				{InstanceVolumeType: instanceSDK.VolumeVolumeTypeLSSD, Size: scw.SizePtr(800 * scw.GB)},
+			},
+			serverType: scratchServerType,
+		},
+		{
+			name: "Scratch volumes exceeding the scratch storage",
+			volumes: []*UnknownVolume{
+				{InstanceVolumeType: instanceSDK.VolumeVolumeTypeScratch, Size: scw.SizePtr(800 * scw.GB)},
+				{InstanceVolumeType: instanceSDK.VolumeVolumeTypeScratch, Size: scw.SizePtr(1000 * scw.GB)},
+			},
+			serverType:  scratchServerType,
+			expectedErr: "PRO2-XXS total scratch volume size must not exceed 1.6 TB",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateScratchVolumes(tt.volumes, tt.serverType, "PRO2-XXS")
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
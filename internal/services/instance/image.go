@@ -46,7 +46,7 @@ func ResourceImage() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				Description:      "UUID of the snapshot from which the image is to be created",
-				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithZone(),
 			},
 			"architecture": {
 				Type:             schema.TypeString,
@@ -61,7 +61,7 @@ func ResourceImage() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
-					ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+					ValidateDiagFunc: verify.IsUUIDorUUIDWithZone(),
 				},
 				Description: "The IDs of the additional volumes attached to the image",
 			},
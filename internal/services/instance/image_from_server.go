@@ -0,0 +1,315 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func ResourceImageFromServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceInstanceImageFromServerCreate,
+		ReadContext:   ResourceInstanceImageFromServerRead,
+		UpdateContext: ResourceInstanceImageFromServerUpdate,
+		DeleteContext: ResourceInstanceImageFromServerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultInstanceImageTimeout),
+			Read:    schema.DefaultTimeout(defaultInstanceImageTimeout),
+			Update:  schema.DefaultTimeout(defaultInstanceImageTimeout),
+			Delete:  schema.DefaultTimeout(defaultInstanceImageTimeout),
+			Default: schema.DefaultTimeout(defaultInstanceImageTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The ID of the server to create the image from",
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithZone(),
+				DiffSuppressFunc: dsf.Locality,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the image",
+			},
+			"stop_server": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Stop the server while its volumes are snapshotted and start it again once the image is created",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of tags [\"tag1\", \"tag2\", ...] attached to the image",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			// Computed
+			"root_snapshot_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the snapshot of the server's root volume",
+			},
+			"additional_snapshot_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the snapshots of the server's additional volumes",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"architecture": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Architecture of the image",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the image [ available | creating | error ]",
+			},
+			"creation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the creation of the image",
+			},
+			"modification_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the last modification of the image",
+			},
+			// Common
+			"zone": zonal.Schema(),
+			"project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The project of the image, always the one of the server",
+			},
+			"organization_id": account.OrganizationIDSchema(),
+		},
+		CustomizeDiff: cdf.LocalityCheck("server_id"),
+	}
+}
+
+func ResourceInstanceImageFromServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, zone, err := instanceAndBlockAPIWithZone(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serverID := zonal.ExpandID(d.Get("server_id").(string)).ID
+	// Other resources must not act on the server while it is stopped and backed up
+	unlock := lockServer(zone, serverID)
+	defer unlock()
+
+	server, err := waitForServer(ctx, api.API, zone, serverID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	restartServer := d.Get("stop_server").(bool) && server.State == instanceSDK.ServerStateRunning
+	if restartServer {
+		err = reachState(ctx, api, zone, serverID, instanceSDK.ServerStateStopped, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to stop server before creating its image: %w", err))
+		}
+	}
+
+	diags := createImageFromServer(ctx, d, api, zone, serverID)
+
+	// The server is started again even if the image failed, the image is then tainted and replaced on next apply.
+	if restartServer {
+		err = reachState(ctx, api, zone, serverID, instanceSDK.ServerStateRunning, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			diags = append(diags, diag.FromErr(fmt.Errorf("failed to start server after creating its image: %w", err))...)
+		}
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	return ResourceInstanceImageFromServerRead(ctx, d, m)
+}
+
+// createImageFromServer backs up all the volumes of the server in a single image and waits for it.
+func createImageFromServer(ctx context.Context, d *schema.ResourceData, api *BlockAndInstanceAPI, zone scw.Zone, serverID string) diag.Diagnostics {
+	res, err := api.ServerAction(&instanceSDK.ServerActionRequest{
+		Zone:     zone,
+		ServerID: serverID,
+		Action:   instanceSDK.ServerActionBackup,
+		Name:     types.ExpandStringPtr(types.ExpandOrGenerateString(d.Get("name"), "image")),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The backup task returns the image it creates as /images/<image_id>
+	if res.Task == nil || res.Task.HrefResult == "" {
+		return diag.FromErr(errors.New("server backup did not return the created image"))
+	}
+	imageID := path.Base(res.Task.HrefResult)
+
+	d.SetId(zonal.NewIDString(zone, imageID))
+
+	image, err := waitForImage(ctx, api.API, zone, imageID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if image.State == instanceSDK.ImageStateError {
+		return diag.FromErr(fmt.Errorf("image %s created from server %s is in error state", imageID, serverID))
+	}
+
+	if tags, tagsExist := d.GetOk("tags"); tagsExist {
+		_, err = api.UpdateImage(&instanceSDK.UpdateImageRequest{
+			Zone:         zone,
+			ImageID:      imageID,
+			Name:         &image.Name,
+			Arch:         image.Arch,
+			ExtraVolumes: expandImageExtraVolumesUpdateTemplates(imageExtraVolumesSnapshotIDs(image.ExtraVolumes)),
+			Tags:         types.ExpandStringsPtr(tags),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("couldn't tag image: %w", err))
+		}
+	}
+
+	return nil
+}
+
+func ResourceInstanceImageFromServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	instanceAPI, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	image, err := instanceAPI.GetImage(&instanceSDK.GetImageRequest{
+		Zone:    zone,
+		ImageID: id,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if httperrors.Is404(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if image.Image.FromServer != "" {
+		_ = d.Set("server_id", zonal.NewIDString(zone, image.Image.FromServer))
+	}
+	_ = d.Set("name", image.Image.Name)
+	_ = d.Set("tags", image.Image.Tags)
+	if image.Image.RootVolume != nil {
+		_ = d.Set("root_snapshot_id", zonal.NewIDString(zone, image.Image.RootVolume.ID))
+	}
+	additionalSnapshotIDs := []string(nil)
+	for _, snapshotID := range imageExtraVolumesSnapshotIDs(image.Image.ExtraVolumes) {
+		additionalSnapshotIDs = append(additionalSnapshotIDs, zonal.NewIDString(zone, snapshotID))
+	}
+	_ = d.Set("additional_snapshot_ids", additionalSnapshotIDs)
+	_ = d.Set("architecture", image.Image.Arch.String())
+	_ = d.Set("state", image.Image.State.String())
+	_ = d.Set("creation_date", types.FlattenTime(image.Image.CreationDate))
+	_ = d.Set("modification_date", types.FlattenTime(image.Image.ModificationDate))
+	_ = d.Set("zone", image.Image.Zone)
+	_ = d.Set("project_id", image.Image.Project)
+	_ = d.Set("organization_id", image.Image.Organization)
+
+	return nil
+}
+
+func ResourceInstanceImageFromServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	instanceAPI, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	image, err := waitForImage(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = instanceAPI.UpdateImage(&instanceSDK.UpdateImageRequest{
+		Zone:         zone,
+		ImageID:      id,
+		Name:         types.ExpandStringPtr(d.Get("name")),
+		Arch:         image.Arch,
+		ExtraVolumes: expandImageExtraVolumesUpdateTemplates(imageExtraVolumesSnapshotIDs(image.ExtraVolumes)),
+		Tags:         types.ExpandUpdatedStringsPtr(d.Get("tags")),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("couldn't update image: %w", err))
+	}
+
+	_, err = waitForImage(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ResourceInstanceImageFromServerRead(ctx, d, m)
+}
+
+func ResourceInstanceImageFromServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, zone, id, err := instanceAndBlockAPIWithZoneAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	image, err := waitForImage(ctx, api.API, zone, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		if httperrors.Is404(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	err = api.DeleteImage(&instanceSDK.DeleteImageRequest{
+		Zone:    zone,
+		ImageID: id,
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	// The snapshots were created along with the image by the backup, they are deleted with it.
+	snapshotIDs := []string(nil)
+	if image.RootVolume != nil {
+		snapshotIDs = append(snapshotIDs, image.RootVolume.ID)
+	}
+	snapshotIDs = append(snapshotIDs, imageExtraVolumesSnapshotIDs(image.ExtraVolumes)...)
+
+	for _, snapshotID := range snapshotIDs {
+		err = api.DeleteUnknownSnapshot(&DeleteUnknownSnapshotRequest{
+			Zone:       zone,
+			SnapshotID: snapshotID,
+		}, scw.WithContext(ctx))
+		if err != nil && !httperrors.Is404(err) {
+			return diag.FromErr(fmt.Errorf("failed to delete snapshot %s of image %s: %w", snapshotID, id, err))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package instance_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	instancechecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/testfuncs"
+)
+
+func TestAccImageFromServer_Basic(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			isImageDestroyed(tt),
+			instancechecks.IsServerDestroyed(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_server" "main" {
+						image = "ubuntu_jammy"
+						type  = "DEV1-S"
+					}`,
+			},
+			{
+				// The user data is applied while the image is created, once the server is started again
+				Config: `
+					resource "scaleway_instance_server" "main" {
+						image = "ubuntu_jammy"
+						type  = "DEV1-S"
+					}
+
+					resource "scaleway_instance_image_from_server" "main" {
+						server_id   = scaleway_instance_server.main.id
+						name        = "tf-tests-image-from-server"
+						stop_server = true
+					}
+
+					resource "scaleway_instance_user_data" "main" {
+						server_id = scaleway_instance_server.main.id
+						key       = "cloud-init"
+						value     = "#cloud-config"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					instancechecks.DoesImageExists(tt, "scaleway_instance_image_from_server.main"),
+					resource.TestCheckResourceAttrPair("scaleway_instance_image_from_server.main", "server_id", "scaleway_instance_server.main", "id"),
+					resource.TestCheckResourceAttr("scaleway_instance_image_from_server.main", "name", "tf-tests-image-from-server"),
+					resource.TestCheckResourceAttrSet("scaleway_instance_image_from_server.main", "root_snapshot_id"),
+					resource.TestCheckResourceAttr("scaleway_instance_user_data.main", "value", "#cloud-config"),
+				),
+			},
+			{
+				// The server is started again and its user data is kept
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "state", "started"),
+					resource.TestCheckResourceAttr("scaleway_instance_user_data.main", "value", "#cloud-config"),
+				),
+			},
+			{
+				ResourceName:            "scaleway_instance_image_from_server.main",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"stop_server"},
+			},
+		},
+	})
+}
@@ -0,0 +1,171 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func ResourceIPAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceInstanceIPAttachmentCreate,
+		ReadContext:   ResourceInstanceIPAttachmentRead,
+		UpdateContext: ResourceInstanceIPAttachmentUpdate,
+		DeleteContext: ResourceInstanceIPAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultInstanceIPTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"ip_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: dsf.Locality,
+				Description:      "The ID of the IP to attach",
+			},
+			"server_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: dsf.Locality,
+				Description:      "The ID of the server the IP is attached to, changing it moves the IP to the new server",
+			},
+			"zone": zonal.Schema(),
+		},
+		CustomizeDiff: cdf.LocalityCheck("ip_id", "server_id"),
+	}
+}
+
+func ResourceInstanceIPAttachmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := newAPIWithZone(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ipID := locality.ExpandID(d.Get("ip_id"))
+	serverID := locality.ExpandID(d.Get("server_id"))
+	unlock := lockServer(zone, serverID)
+	defer unlock()
+
+	_, err = instanceAPI.UpdateIP(&instanceSDK.UpdateIPRequest{
+		Zone:   zone,
+		IP:     ipID,
+		Server: &instanceSDK.NullableStringValue{Value: serverID},
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(zonal.NewIDString(zone, ipID))
+
+	_, err = waitForIPAttachment(ctx, instanceAPI, zone, ipID, serverID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ResourceInstanceIPAttachmentRead(ctx, d, m)
+}
+
+func ResourceInstanceIPAttachmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	instanceAPI, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := instanceAPI.GetIP(&instanceSDK.GetIPRequest{
+		Zone: zone,
+		IP:   id,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		// We check for 403 because instanceSDK API returns 403 for a deleted IP
+		if httperrors.Is404(err) || httperrors.Is403(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if res.IP.Server == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("ip_id", zonal.NewIDString(zone, res.IP.ID))
+	_ = d.Set("server_id", zonal.NewIDString(zone, res.IP.Server.ID))
+	_ = d.Set("zone", zone.String())
+
+	return nil
+}
+
+func ResourceInstanceIPAttachmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	instanceAPI, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("server_id") {
+		// The IP is moved to the new server in a single call, it is never left detached.
+		oldServerID, newServerID := d.GetChange("server_id")
+		serverID := locality.ExpandID(newServerID)
+		unlock := lockServers(zone, locality.ExpandID(oldServerID), serverID)
+		defer unlock()
+
+		_, err = instanceAPI.UpdateIP(&instanceSDK.UpdateIPRequest{
+			Zone:   zone,
+			IP:     id,
+			Server: &instanceSDK.NullableStringValue{Value: serverID},
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = waitForIPAttachment(ctx, instanceAPI, zone, id, serverID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ResourceInstanceIPAttachmentRead(ctx, d, m)
+}
+
+func ResourceInstanceIPAttachmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	instanceAPI, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	unlock := lockServer(zone, locality.ExpandID(d.Get("server_id")))
+	defer unlock()
+
+	_, err = instanceAPI.UpdateIP(&instanceSDK.UpdateIPRequest{
+		Zone:   zone,
+		IP:     id,
+		Server: &instanceSDK.NullableStringValue{Null: true},
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if httperrors.Is404(err) || httperrors.Is403(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	_, err = waitForIPAttachment(ctx, instanceAPI, zone, id, "", d.Timeout(schema.TimeoutDelete))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package instance_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	instancechecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/testfuncs"
+)
+
+func TestAccIPAttachment_Basic(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			instancechecks.IsIPDestroyed(tt),
+			instancechecks.IsServerDestroyed(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				// The user data of the server is set concurrently with the attachment of the IP
+				Config: fmt.Sprintf(`
+					resource "scaleway_instance_ip" "main" {}
+
+					resource "scaleway_instance_server" "blue" {
+						image = "ubuntu_jammy"
+						type  = "DEV1-S"
+					}
+
+					resource "scaleway_instance_server" "green" {
+						image = "ubuntu_jammy"
+						type  = "DEV1-S"
+					}
+
+					resource "scaleway_instance_user_data" "blue" {
+						server_id = scaleway_instance_server.blue.id
+						key       = "cloud-init"
+						value     = "#cloud-config"
+					}
+
+					resource "scaleway_instance_ip_attachment" "main" {
+						ip_id     = scaleway_instance_ip.main.id
+						server_id = scaleway_instance_server.%s.id
+					}`, "blue"),
+				Check: resource.ComposeTestCheckFunc(
+					instancechecks.CheckIPExists(tt, "scaleway_instance_ip.main"),
+					resource.TestCheckResourceAttrPair("scaleway_instance_ip_attachment.main", "server_id", "scaleway_instance_server.blue", "id"),
+					isIPAttachedToServer(tt, "scaleway_instance_ip.main", "scaleway_instance_server.blue"),
+					serverHasNoIPAssigned(tt, "scaleway_instance_server.green"),
+				),
+			},
+			{
+				// The IP is moved from a server to the other in a single apply
+				Config: fmt.Sprintf(`
+					resource "scaleway_instance_ip" "main" {}
+
+					resource "scaleway_instance_server" "blue" {
+						image = "ubuntu_jammy"
+						type  = "DEV1-S"
+					}
+
+					resource "scaleway_instance_server" "green" {
+						image = "ubuntu_jammy"
+						type  = "DEV1-S"
+					}
+
+					resource "scaleway_instance_user_data" "blue" {
+						server_id = scaleway_instance_server.blue.id
+						key       = "cloud-init"
+						value     = "#cloud-config"
+					}
+
+					resource "scaleway_instance_ip_attachment" "main" {
+						ip_id     = scaleway_instance_ip.main.id
+						server_id = scaleway_instance_server.%s.id
+					}`, "green"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("scaleway_instance_ip_attachment.main", "server_id", "scaleway_instance_server.green", "id"),
+					isIPAttachedToServer(tt, "scaleway_instance_ip.main", "scaleway_instance_server.green"),
+					serverHasNoIPAssigned(tt, "scaleway_instance_server.blue"),
+				),
+			},
+			{
+				ResourceName:      "scaleway_instance_ip_attachment.main",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -89,14 +89,18 @@ func ResourceInstancePrivateNICCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	_, err = waitForServer(ctx, instanceAPI, zone, locality.ExpandID(d.Get("server_id")), d.Timeout(schema.TimeoutCreate))
+	serverID := locality.ExpandID(d.Get("server_id"))
+	unlock := lockServer(zone, serverID)
+	defer unlock()
+
+	_, err = waitForServer(ctx, instanceAPI, zone, serverID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	createPrivateNICRequest := &instance.CreatePrivateNICRequest{
 		Zone:             zone,
-		ServerID:         zonal.ExpandID(d.Get("server_id").(string)).ID,
+		ServerID:         serverID,
 		PrivateNetworkID: regional.ExpandID(d.Get("private_network_id").(string)).ID,
 		Tags:             types.ExpandStrings(d.Get("tags")),
 		IPIDs:            types.ExpandStringsPtr(d.Get("ip_ids")),
@@ -202,6 +206,9 @@ func ResourceInstancePrivateNICDelete(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
+	unlock := lockServer(zone, serverID)
+	defer unlock()
+
 	_, err = waitForPrivateNIC(ctx, instanceAPI, zone, serverID, privateNICID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		if httperrors.Is404(err) {
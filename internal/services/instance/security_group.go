@@ -232,6 +232,9 @@ func getSecurityGroupRules(ctx context.Context, instanceAPI *instanceSDK.API, zo
 			// Truncate stateRules with apiRules length
 			stateRules[direction] = stateRules[direction][0:len(apiRules[direction])]
 		}
+		for index, apiRule := range apiRules[direction] {
+			stateRules[direction][index].(map[string]interface{})["position"] = int(apiRule.Position)
+		}
 	}
 
 	return stateRules[instanceSDK.SecurityGroupRuleDirectionInbound], stateRules[instanceSDK.SecurityGroupRuleDirectionOutbound], nil
@@ -306,8 +309,12 @@ func updateSecurityGroupeRules(ctx context.Context, d *schema.ResourceData, zone
 		instanceSDK.SecurityGroupRuleDirectionOutbound: d.Get("outbound_rule").([]interface{}),
 	}
 
+	// Inbound rules are sent first so the request does not depend on map iteration order.
 	setGroupRules := []*instanceSDK.SetSecurityGroupRulesRequestRule{}
-	for direction := range stateRules {
+	for _, direction := range []instanceSDK.SecurityGroupRuleDirection{
+		instanceSDK.SecurityGroupRuleDirectionInbound,
+		instanceSDK.SecurityGroupRuleDirectionOutbound,
+	} {
 		// Loop for all state rules in this direction
 		for _, rawStateRule := range stateRules[direction] {
 			stateRule, err := securityGroupRuleExpand(rawStateRule)
@@ -402,6 +409,11 @@ func securityGroupRuleSchema() *schema.Resource {
 				ValidateFunc: validation.IsCIDRNetwork(0, 128),
 				Description:  "Ip range for this rule (e.g: 192.168.1.0/24). Only one of ip or ip_range should be provided",
 			},
+			"position": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Position of the rule in the security group, rules are evaluated in the order of the list",
+			},
 		},
 	}
 }
@@ -0,0 +1,141 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+)
+
+func DataSourceSecurityGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceInstanceSecurityGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Security groups with a name like it are listed.",
+			},
+			"tags": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Security groups with these exact tags are listed.",
+			},
+			"security_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"name": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"description": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"stateful": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+						"inbound_default_policy": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"outbound_default_policy": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"enable_default_security": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+						"project_default": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+						"tags": {
+							Computed: true,
+							Type:     schema.TypeList,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"server_ids": {
+							Computed: true,
+							Type:     schema.TypeList,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"zone":            zonal.Schema(),
+						"organization_id": account.OrganizationIDSchema(),
+						"project_id":      account.ProjectIDSchema(),
+					},
+				},
+			},
+			"zone":            zonal.Schema(),
+			"organization_id": account.OrganizationIDSchema(),
+			"project_id":      account.ProjectIDSchema(),
+		},
+	}
+}
+
+func DataSourceInstanceSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := newAPIWithZone(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := instanceAPI.ListSecurityGroups(&instance.ListSecurityGroupsRequest{
+		Zone:    zone,
+		Name:    types.ExpandStringPtr(d.Get("name")),
+		Project: types.ExpandStringPtr(d.Get("project_id")),
+		Tags:    types.ExpandStrings(d.Get("tags")),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	securityGroups := []interface{}(nil)
+	for _, securityGroup := range res.SecurityGroups {
+		rawSecurityGroup := make(map[string]interface{})
+		rawSecurityGroup["id"] = zonal.NewIDString(zone, securityGroup.ID)
+		rawSecurityGroup["name"] = securityGroup.Name
+		rawSecurityGroup["description"] = securityGroup.Description
+		rawSecurityGroup["stateful"] = securityGroup.Stateful
+		rawSecurityGroup["inbound_default_policy"] = securityGroup.InboundDefaultPolicy.String()
+		rawSecurityGroup["outbound_default_policy"] = securityGroup.OutboundDefaultPolicy.String()
+		rawSecurityGroup["enable_default_security"] = securityGroup.EnableDefaultSecurity
+		rawSecurityGroup["project_default"] = securityGroup.ProjectDefault
+		if len(securityGroup.Tags) > 0 {
+			rawSecurityGroup["tags"] = securityGroup.Tags
+		}
+		serverIDs := make([]string, 0, len(securityGroup.Servers))
+		for _, server := range securityGroup.Servers {
+			serverIDs = append(serverIDs, zonal.NewIDString(zone, server.ID))
+		}
+		rawSecurityGroup["server_ids"] = serverIDs
+		rawSecurityGroup["zone"] = zone.String()
+		rawSecurityGroup["organization_id"] = securityGroup.Organization
+		rawSecurityGroup["project_id"] = securityGroup.Project
+
+		securityGroups = append(securityGroups, rawSecurityGroup)
+	}
+
+	d.SetId(zone.String())
+	_ = d.Set("security_groups", securityGroups)
+
+	return nil
+}
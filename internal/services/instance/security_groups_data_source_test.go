@@ -0,0 +1,71 @@
+package instance_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceSecurityGroups_Basic(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      isSecurityGroupDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_security_group" "sg1" {
+						name = "tf-security-groups-datasource0"
+						tags = [ "terraform-test", "data_scaleway_instance_security_groups", "basic" ]
+					}
+
+					resource "scaleway_instance_security_group" "sg2" {
+						name = "tf-security-groups-datasource1"
+						tags = [ "terraform-test", "data_scaleway_instance_security_groups", "basic" ]
+					}`,
+			},
+			{
+				Config: `
+					resource "scaleway_instance_security_group" "sg1" {
+						name = "tf-security-groups-datasource0"
+						tags = [ "terraform-test", "data_scaleway_instance_security_groups", "basic" ]
+					}
+
+					resource "scaleway_instance_security_group" "sg2" {
+						name = "tf-security-groups-datasource1"
+						tags = [ "terraform-test", "data_scaleway_instance_security_groups", "basic" ]
+					}
+
+					data "scaleway_instance_security_groups" "by_name" {
+						name = "tf-security-groups-datasource"
+					}
+
+					data "scaleway_instance_security_groups" "by_tag" {
+						tags = ["data_scaleway_instance_security_groups", "terraform-test"]
+					}
+
+					data "scaleway_instance_security_groups" "by_name_other_zone" {
+						name = "tf-security-groups-datasource"
+						zone = "fr-par-2"
+					}
+					`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_instance_security_groups.by_name", "security_groups.#", "2"),
+					resource.TestCheckResourceAttrSet("data.scaleway_instance_security_groups.by_name", "security_groups.0.id"),
+					resource.TestCheckResourceAttrSet("data.scaleway_instance_security_groups.by_name", "security_groups.1.id"),
+
+					resource.TestCheckResourceAttr("data.scaleway_instance_security_groups.by_tag", "security_groups.#", "2"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_security_groups.by_tag", "security_groups.0.tags.#", "3"),
+
+					resource.TestCheckNoResourceAttr("data.scaleway_instance_security_groups.by_name_other_zone", "security_groups.0.id"),
+				),
+			},
+		},
+	})
+}
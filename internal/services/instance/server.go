@@ -9,6 +9,7 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
@@ -23,6 +24,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	scwvalidation "github.com/scaleway/scaleway-sdk-go/validation"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/console"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
@@ -31,6 +33,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -163,12 +166,22 @@ func ResourceServer() *schema.Resource {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
-					ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+					ValidateDiagFunc: verify.IsUUIDorUUIDWithZone(),
 					DiffSuppressFunc: dsf.Locality,
 				},
 				Optional:    true,
 				Description: "The additional volumes attached to the server",
 			},
+			"external_volume_ids": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: verify.IsUUIDorUUIDWithZone(),
+					DiffSuppressFunc: dsf.Locality,
+				},
+				Optional:    true,
+				Description: "The volumes attached to the server whose lifecycle is managed elsewhere, they are detached but never deleted when the server is destroyed",
+			},
 			"enable_ipv6": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -232,6 +245,11 @@ func ResourceServer() *schema.Resource {
 				Deprecated:  "Please use a scaleway_instance_ip with a `routed_ipv6` type",
 				Description: "The IPv6 prefix length routed to the server.",
 			},
+			"mac_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The MAC address of the server's public network interface",
+			},
 			"enable_dynamic_ip": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -296,7 +314,7 @@ func ResourceServer() *schema.Resource {
 						"pn_id": {
 							Type:             schema.TypeString,
 							Required:         true,
-							ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+							ValidateDiagFunc: verify.IsUUIDorUUIDWithRegion(),
 							Description:      "The Private Network ID",
 							DiffSuppressFunc: dsf.Locality,
 						},
@@ -362,19 +380,54 @@ func ResourceServer() *schema.Resource {
 				},
 				Deprecated: "Routed IP is the default configuration, it should always be true",
 			},
+			"admin_password_encryption_ssh_key_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The ID of the IAM SSH key used to encrypt the initial admin password on supported images",
+				ValidateDiagFunc: verify.IsUUID(),
+			},
+			"wait_for_cloud_init": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait, on creation, for the server to report the end of its boot through the metadata API",
+			},
+			"admin_password_encrypted_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The initial admin password, encrypted with the public key of admin_password_encryption_ssh_key_id",
+			},
+			"protected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Prevent the server from being deleted through the API",
+			},
+			"force_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Remove the protection of the server before deleting it",
+			},
 			"zone":            zonal.Schema(),
 			"organization_id": account.OrganizationIDSchema(),
+			"console_url":     console.URLSchema(),
 			"project_id":      account.ProjectIDSchema(),
 		},
 		CustomizeDiff: customdiff.All(
 			cdf.LocalityCheck(
 				"placement_group_id",
 				"additional_volume_ids.#",
+				"external_volume_ids.#",
 				"ip_id",
 			),
+			customDiffInstanceExternalVolumes,
 			customDiffInstanceServerType,
 			customDiffInstanceServerImage,
 			customDiffInstanceRootVolumeSize,
+			customDiffInstanceLocalVolumesSize,
+			customDiffInstanceWaitForCloudInit,
 		),
 	}
 }
@@ -427,6 +480,10 @@ func ResourceInstanceServerCreate(ctx context.Context, d *schema.ResourceData, m
 		req.PlacementGroup = types.ExpandStringPtr(zonal.ExpandID(placementGroupID).ID)
 	}
 
+	if sshKeyID, ok := d.GetOk("admin_password_encryption_ssh_key_id"); ok {
+		req.AdminPasswordEncryptionSSHKeyID = types.ExpandStringPtr(sshKeyID)
+	}
+
 	serverType := getServerType(ctx, api.API, req.Zone, req.CommercialType)
 	if serverType == nil {
 		return diag.Diagnostics{{
@@ -441,15 +498,26 @@ func ResourceInstanceServerCreate(ctx context.Context, d *schema.ResourceData, m
 	rootVolume := d.Get("root_volume.0").(map[string]any)
 
 	req.Volumes["0"] = prepareRootVolume(rootVolume, serverType, imageUUID).VolumeTemplate()
+	additionalVolumes := []*UnknownVolume(nil)
 	if raw, ok := d.GetOk("additional_volume_ids"); ok {
 		for i, volumeID := range raw.([]interface{}) {
-			// We have to get the volume to know whether it is a local or a block volume
-			volumeTemplate, err := instanceServerAdditionalVolumeTemplate(api, zone, volumeID.(string))
+			// We have to get the volume to know whether it is a local, scratch or block volume
+			volume, err := instanceServerAdditionalVolume(api, zone, volumeID.(string))
 			if err != nil {
 				return diag.FromErr(fmt.Errorf("failed to get additional volume: %w", err))
 			}
-			req.Volumes[strconv.Itoa(i+1)] = volumeTemplate
+			additionalVolumes = append(additionalVolumes, volume)
+			req.Volumes[strconv.Itoa(i+1)] = volume.VolumeTemplate()
+		}
+	}
+	// External volumes are attached after the additional ones
+	for _, volumeID := range types.ExpandStrings(d.Get("external_volume_ids")) {
+		volume, err := instanceServerAdditionalVolume(api, zone, volumeID)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to get external volume: %w", err))
 		}
+		additionalVolumes = append(additionalVolumes, volume)
+		req.Volumes[strconv.Itoa(len(req.Volumes))] = volume.VolumeTemplate()
 	}
 
 	// Validate total local volume sizes.
@@ -457,6 +525,10 @@ func ResourceInstanceServerCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	if err = validateScratchVolumes(additionalVolumes, serverType, req.CommercialType); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if imageUUID != "" && !scwvalidation.IsUUID(imageUUID) {
 		// Replace dashes with underscores ubuntu-focal -> ubuntu_focal
 		imageLabel := formatImageLabel(imageUUID)
@@ -485,6 +557,12 @@ func ResourceInstanceServerCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(zonal.NewID(zone, res.Server.ID).String())
 
+	// The API only keeps the admin password encryption key of servers whose image generates an admin password,
+	// waiting for the password of other servers would block until the timeout.
+	if sshKeyID, ok := d.GetOk("admin_password_encryption_ssh_key_id"); ok && types.FlattenStringPtr(res.Server.AdminPasswordEncryptionSSHKeyID) != sshKeyID {
+		return diag.Errorf("admin_password_encryption_ssh_key_id is not supported by the image of server %s", res.Server.ID)
+	}
+
 	_, err = waitForServer(ctx, api.API, zone, res.Server.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
@@ -538,11 +616,19 @@ func ResourceInstanceServerCreate(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	err = reachState(ctx, api, zone, res.Server.ID, targetState)
+	err = reachState(ctx, api, zone, res.Server.ID, targetState, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	// The admin password is generated and encrypted by the image on first boot
+	if _, ok := d.GetOk("admin_password_encryption_ssh_key_id"); ok && targetState == instanceSDK.ServerStateRunning {
+		_, err = waitForServerRDPPassword(ctx, api.API, zone, res.Server.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	////
 	// Private Network
 	////
@@ -580,6 +666,26 @@ func ResourceInstanceServerCreate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	// The boot is reported by the server itself, private servers do not need to be reachable
+	if d.Get("wait_for_cloud_init").(bool) {
+		err = waitForServerBooted(ctx, api.API, zone, res.Server.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// The protection is set last so a server failing to be created can still be deleted
+	if d.Get("protected").(bool) {
+		_, err = api.UpdateServer(&instanceSDK.UpdateServerRequest{
+			Zone:      zone,
+			ServerID:  res.Server.ID,
+			Protected: scw.BoolPtr(true),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return append(diags, ResourceInstanceServerRead(ctx, d, m)...)
 }
 
@@ -614,8 +720,10 @@ func ResourceInstanceServerRead(ctx context.Context, d *schema.ResourceData, m i
 		}
 		_ = d.Set("state", state)
 		_ = d.Set("zone", string(zone))
+		_ = d.Set("console_url", console.URL("instance/servers", zone.String(), server.ID))
 		_ = d.Set("name", server.Name)
 		_ = d.Set("boot_type", server.BootType)
+		_ = d.Set("mac_address", server.MacAddress)
 
 		_ = d.Set("type", server.CommercialType)
 		if len(server.Tags) > 0 {
@@ -625,9 +733,12 @@ func ResourceInstanceServerRead(ctx context.Context, d *schema.ResourceData, m i
 		// EnableIPv6 is deprecated
 		_ = d.Set("enable_ipv6", server.EnableIPv6) //nolint:staticcheck
 		_ = d.Set("enable_dynamic_ip", server.DynamicIPRequired)
+		_ = d.Set("protected", server.Protected)
 		_ = d.Set("organization_id", server.Organization)
 		_ = d.Set("project_id", server.Project)
 		_ = d.Set("routed_ip_enabled", server.RoutedIPEnabled) //nolint:staticcheck
+		_ = d.Set("admin_password_encryption_ssh_key_id", types.FlattenStringPtr(server.AdminPasswordEncryptionSSHKeyID))
+		_ = d.Set("admin_password_encrypted_value", types.FlattenStringPtr(server.AdminPasswordEncryptedValue))
 
 		// Image could be empty in an import context.
 		image := regional.ExpandID(d.Get("image").(string))
@@ -691,7 +802,13 @@ func ResourceInstanceServerRead(ctx context.Context, d *schema.ResourceData, m i
 			_ = d.Set("ipv6_prefix_length", nil)
 		}
 
+		externalVolumeIDs := map[string]struct{}{}
+		for _, volumeID := range locality.ExpandIDs(d.Get("external_volume_ids")) {
+			externalVolumeIDs[volumeID] = struct{}{}
+		}
+
 		var additionalVolumesIDs []string
+		var attachedExternalVolumeIDs []string
 		for i, serverVolume := range sortVolumeServer(server.Volumes) {
 			if i == 0 {
 				rootVolume := map[string]interface{}{}
@@ -725,11 +842,14 @@ func ResourceInstanceServerRead(ctx context.Context, d *schema.ResourceData, m i
 				rootVolume["name"] = serverVolume.Name
 
 				_ = d.Set("root_volume", []map[string]interface{}{rootVolume})
+			} else if _, isExternal := externalVolumeIDs[serverVolume.ID]; isExternal {
+				attachedExternalVolumeIDs = append(attachedExternalVolumeIDs, zonal.NewID(zone, serverVolume.ID).String())
 			} else {
 				additionalVolumesIDs = append(additionalVolumesIDs, zonal.NewID(zone, serverVolume.ID).String())
 			}
 		}
 
+		_ = d.Set("external_volume_ids", attachedExternalVolumeIDs)
 		_ = d.Set("additional_volume_ids", additionalVolumesIDs)
 		if len(additionalVolumesIDs) > 0 {
 			_ = d.Set("additional_volume_ids", additionalVolumesIDs)
@@ -782,6 +902,9 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	unlock := lockServer(zone, id)
+	defer unlock()
+
 	wantedState := d.Get("state").(string)
 	isStopped := wantedState == InstanceServerStateStopped
 
@@ -828,7 +951,18 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 		updateRequest.DynamicIPRequired = scw.BoolPtr(d.Get("enable_dynamic_ip").(bool))
 	}
 
-	if d.HasChanges("additional_volume_ids", "root_volume") {
+	if d.HasChange("protected") {
+		serverShouldUpdate = true
+		updateRequest.Protected = scw.BoolPtr(d.Get("protected").(bool))
+	}
+
+	if d.HasChange("admin_password_encryption_ssh_key_id") {
+		serverShouldUpdate = true
+		// An empty string resets both the key and the encrypted value, so a new password may be generated
+		updateRequest.AdminPasswordEncryptionSSHKeyID = scw.StringPtr(d.Get("admin_password_encryption_ssh_key_id").(string))
+	}
+
+	if d.HasChanges("additional_volume_ids", "external_volume_ids", "root_volume") {
 		volumes, err := instanceServerVolumesUpdate(ctx, d, api, zone, isStopped)
 		if err != nil {
 			return diag.FromErr(err)
@@ -1012,7 +1146,7 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 			return diag.FromErr(err)
 		}
 		// reach expected state
-		err = reachState(ctx, api, zone, id, targetState)
+		err = reachState(ctx, api, zone, id, targetState, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -1056,6 +1190,25 @@ func ResourceInstanceServerDelete(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	unlock := lockServer(zone, id)
+	defer unlock()
+
+	// Check the protection first, the server must not be stopped or detached if it cannot be deleted
+	if d.Get("protected").(bool) {
+		if !d.Get("force_delete").(bool) {
+			return diag.Errorf("server %s is protected, set protected to false or force_delete to true before deleting it", d.Id())
+		}
+
+		_, err = api.UpdateServer(&instanceSDK.UpdateServerRequest{
+			Zone:      zone,
+			ServerID:  id,
+			Protected: scw.BoolPtr(false),
+		}, scw.WithContext(ctx))
+		if err != nil && !httperrors.Is404(err) {
+			return diag.FromErr(err)
+		}
+	}
+
 	// detach eip to ensure to free eip even if instanceSDK won't stop
 	if ipID, ok := d.GetOk("ip_id"); ok {
 		_, err := api.UpdateIP(&instanceSDK.UpdateIPRequest{
@@ -1079,7 +1232,7 @@ func ResourceInstanceServerDelete(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	// reach stopped state
-	err = reachState(ctx, api, zone, id, instanceSDK.ServerStateStopped)
+	err = reachState(ctx, api, zone, id, instanceSDK.ServerStateStopped, d.Timeout(schema.TimeoutDelete))
 	if httperrors.Is404(err) {
 		return nil
 	}
@@ -1104,6 +1257,19 @@ func ResourceInstanceServerDelete(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	// External volumes are detached so they are kept when the server is deleted
+	err = instanceServerDetachVolumes(ctx, api, zone, id, locality.ExpandIDs(d.Get("external_volume_ids")), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if m.(*meta.Meta).Features().InstanceServerDetachVolumesOnDestroy {
+		err = instanceServerDetachVolumes(ctx, api, zone, id, locality.ExpandIDs(d.Get("additional_volume_ids")), d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	_, err = waitForServer(ctx, api.API, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
 		return diag.FromErr(err)
@@ -1141,6 +1307,51 @@ func ResourceInstanceServerDelete(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+// instanceServerDetachVolumes detaches the block volumes of the server so they are kept when it is deleted.
+// Local volumes are skipped as they cannot be attached to another server.
+func instanceServerDetachVolumes(ctx context.Context, api *BlockAndInstanceAPI, zone scw.Zone, serverID string, volumeIDs []string, timeout time.Duration) error {
+	for _, volumeID := range volumeIDs {
+		volume, err := api.GetUnknownVolume(&GetUnknownVolumeRequest{
+			Zone:     zone,
+			VolumeID: volumeID,
+		}, scw.WithContext(ctx))
+		if httperrors.Is404(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get volume %s: %w", volumeID, err)
+		}
+		if volume.IsLocal() || volume.ServerID == nil || *volume.ServerID != serverID {
+			continue
+		}
+
+		_, err = api.DetachServerVolume(&instanceSDK.DetachServerVolumeRequest{
+			Zone:     zone,
+			ServerID: serverID,
+			VolumeID: volumeID,
+		}, scw.WithContext(ctx))
+		if err != nil && !httperrors.Is404(err) {
+			return fmt.Errorf("failed to detach volume %s: %w", volumeID, err)
+		}
+
+		if volume.IsBlockVolume() {
+			_, err = api.blockAPI.WaitForVolumeAndReferences(&block.WaitForVolumeAndReferencesRequest{
+				Zone:          zone,
+				VolumeID:      volumeID,
+				RetryInterval: transport.DefaultWaitRetryInterval,
+				Timeout:       scw.TimeDurationPtr(timeout),
+			}, scw.WithContext(ctx))
+		} else {
+			_, err = waitForVolume(ctx, api.API, zone, volumeID, timeout)
+		}
+		if err != nil && !httperrors.Is404(err) {
+			return fmt.Errorf("failed to wait for volume %s to be detached: %w", volumeID, err)
+		}
+	}
+
+	return nil
+}
+
 func instanceServerCanMigrate(api *instanceSDK.API, server *instanceSDK.Server, requestedType string) error {
 	var localVolumeSize scw.Size
 
@@ -1197,6 +1408,123 @@ func customDiffInstanceRootVolumeSize(_ context.Context, diff *schema.ResourceDi
 	return nil
 }
 
+// customDiffInstanceLocalVolumesSize checks at plan time that the root and additional local volumes fit in the local storage of the server type.
+// The check reads the server type and the additional volumes, so it only runs when enabled in the provider features.
+func customDiffInstanceLocalVolumesSize(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !m.(*meta.Meta).Features().InstanceServerCheckLocalVolumesSizeOnPlan {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChanges("root_volume.0.size_in_gb", "additional_volume_ids", "external_volume_ids") {
+		return nil
+	}
+	// Sizes cannot be checked before the volumes or the type are known.
+	if !diff.NewValueKnown("type") || !diff.NewValueKnown("additional_volume_ids") || !diff.NewValueKnown("external_volume_ids") {
+		return nil
+	}
+	rootVolumeSize := diff.Get("root_volume.0.size_in_gb")
+	if !diff.NewValueKnown("root_volume.0.size_in_gb") {
+		// A root volume size not set in the configuration of a new server is computed at creation.
+		if diff.Id() != "" || !instanceServerRootVolumeSizeIsUnset(diff.GetRawConfig()) {
+			return nil
+		}
+		rootVolumeSize = 0
+	}
+	if _, rootVolumeIsImported := diff.GetOk("root_volume.0.volume_id"); rootVolumeIsImported && diff.Id() == "" {
+		return nil
+	}
+
+	zone, err := meta.ExtractZone(diff, m)
+	if err != nil {
+		return err
+	}
+	api := NewBlockAndInstanceAPI(meta.ExtractScwClient(m))
+
+	commercialType := diff.Get("type").(string)
+	serverType := getServerType(ctx, api.API, zone, commercialType)
+	if serverType == nil || serverType.VolumesConstraint == nil {
+		return nil
+	}
+
+	rootVolume := prepareRootVolume(map[string]any{
+		"volume_type": diff.Get("root_volume.0.volume_type"),
+		"size_in_gb":  rootVolumeSize,
+	}, serverType, "")
+
+	volumes := map[string]*instanceSDK.VolumeServerTemplate{
+		"0": {
+			VolumeType: rootVolume.InstanceVolumeType,
+			Size:       rootVolume.Size,
+		},
+	}
+
+	volumeIDs := append(types.ExpandStrings(diff.Get("additional_volume_ids")), types.ExpandStrings(diff.Get("external_volume_ids"))...)
+	for i, volumeID := range volumeIDs {
+		volume, err := instanceServerAdditionalVolume(api, zone, volumeID)
+		if err != nil {
+			return fmt.Errorf("failed to check additional volume %s: %w", volumeID, err)
+		}
+		volumes[strconv.Itoa(i+1)] = &instanceSDK.VolumeServerTemplate{
+			VolumeType: volume.InstanceVolumeType,
+			Size:       volume.Size,
+		}
+	}
+
+	return validateLocalVolumeSizes(volumes, serverType, commercialType)
+}
+
+// customDiffInstanceExternalVolumes rejects volumes listed both as additional and external volumes.
+func customDiffInstanceExternalVolumes(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("additional_volume_ids") || !diff.NewValueKnown("external_volume_ids") {
+		return nil
+	}
+
+	additionalVolumeIDs := map[string]struct{}{}
+	for _, volumeID := range locality.ExpandIDs(diff.Get("additional_volume_ids")) {
+		additionalVolumeIDs[volumeID] = struct{}{}
+	}
+
+	for _, volumeID := range locality.ExpandIDs(diff.Get("external_volume_ids")) {
+		if _, exists := additionalVolumeIDs[volumeID]; exists && volumeID != "" {
+			return fmt.Errorf("volume %s cannot be both in additional_volume_ids and external_volume_ids", volumeID)
+		}
+	}
+
+	return nil
+}
+
+// instanceServerRootVolumeSizeIsUnset returns true if the configuration does not set the size of the root volume.
+func instanceServerRootVolumeSizeIsUnset(rawConfig cty.Value) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+
+	rootVolumes := rawConfig.GetAttr("root_volume")
+	if !rootVolumes.IsKnown() {
+		return false
+	}
+	if rootVolumes.IsNull() || rootVolumes.LengthInt() == 0 {
+		return true
+	}
+
+	size := rootVolumes.Index(cty.NumberIntVal(0)).GetAttr("size_in_gb")
+
+	return size.IsKnown() && size.IsNull()
+}
+
+// customDiffInstanceWaitForCloudInit checks that a server waiting for cloud-init is started on creation, the only time it waits.
+// An existing server may then be stopped.
+func customDiffInstanceWaitForCloudInit(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() != "" {
+		return nil
+	}
+
+	if diff.Get("wait_for_cloud_init").(bool) && diff.Get("state").(string) != InstanceServerStateStarted {
+		return fmt.Errorf("wait_for_cloud_init requires the server state to be %s", InstanceServerStateStarted)
+	}
+
+	return nil
+}
+
 func customDiffInstanceServerType(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	if !diff.HasChange("type") || diff.Id() == "" {
 		return nil
@@ -1290,7 +1618,7 @@ func ResourceInstanceServerMigrate(ctx context.Context, d *schema.ResourceData,
 	}
 	beginningState := server.State
 
-	err = reachState(ctx, api, zone, id, instanceSDK.ServerStateStopped)
+	err = reachState(ctx, api, zone, id, instanceSDK.ServerStateStopped, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return fmt.Errorf("failed to stop server before changing server type: %w", err)
 	}
@@ -1304,7 +1632,7 @@ func ResourceInstanceServerMigrate(ctx context.Context, d *schema.ResourceData,
 		return errors.New("failed to change server type server")
 	}
 
-	err = reachState(ctx, api, zone, id, beginningState)
+	err = reachState(ctx, api, zone, id, beginningState, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return fmt.Errorf("failed to start server after changing server type: %w", err)
 	}
@@ -1425,10 +1753,9 @@ func ResourceInstanceServerUpdateRootVolumeIOPS(ctx context.Context, api *BlockA
 }
 
 // instanceServerVolumesUpdate updates root_volume size and returns the list of volumes templates that should be updated for the server.
-// It uses root_volume and additional_volume_ids to build the volumes templates.
+// It uses root_volume, additional_volume_ids and external_volume_ids to build the volumes templates.
 func instanceServerVolumesUpdate(ctx context.Context, d *schema.ResourceData, api *BlockAndInstanceAPI, zone scw.Zone, serverIsStopped bool) (map[string]*instanceSDK.VolumeServerTemplate, error) {
 	volumes := map[string]*instanceSDK.VolumeServerTemplate{}
-	raw, hasAdditionalVolumes := d.GetOk("additional_volume_ids")
 
 	if d.HasChange("root_volume.0.size_in_gb") {
 		err := api.ResizeUnknownVolume(&ResizeUnknownVolumeRequest{
@@ -1447,25 +1774,32 @@ func instanceServerVolumesUpdate(ctx context.Context, d *schema.ResourceData, ap
 		Boot: types.ExpandBoolPtr(d.Get("root_volume.0.boot")),
 	}
 
-	if !hasAdditionalVolumes {
-		raw = []interface{}{} // Set an empty list if not volumes exist
-	}
+	additionalVolumes := []*UnknownVolume(nil)
+	for _, key := range []string{"additional_volume_ids", "external_volume_ids"} {
+		for i, volumeID := range d.Get(key).([]interface{}) {
+			volumeHasChange := d.HasChange(key + "." + strconv.Itoa(i))
+			volume, err := api.GetUnknownVolume(&GetUnknownVolumeRequest{
+				VolumeID: zonal.ExpandID(volumeID).ID,
+				Zone:     zone,
+			}, scw.WithContext(ctx))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get updated volume: %w", err)
+			}
 
-	for i, volumeID := range raw.([]interface{}) {
-		volumeHasChange := d.HasChange("additional_volume_ids." + strconv.Itoa(i))
-		volume, err := api.GetUnknownVolume(&GetUnknownVolumeRequest{
-			VolumeID: zonal.ExpandID(volumeID).ID,
-			Zone:     zone,
-		}, scw.WithContext(ctx))
-		if err != nil {
-			return nil, fmt.Errorf("failed to get updated volume: %w", err)
+			// local and scratch volumes can only be added when the server is stopped
+			if volumeHasChange && !serverIsStopped && (volume.IsLocal() || volume.IsScratch()) && volume.IsAttached() {
+				return nil, errors.New("instance must be stopped to change local volumes")
+			}
+			additionalVolumes = append(additionalVolumes, volume)
+			volumes[strconv.Itoa(len(volumes))] = volume.VolumeTemplate()
 		}
+	}
 
-		// local volumes can only be added when the server is stopped
-		if volumeHasChange && !serverIsStopped && volume.IsLocal() && volume.IsAttached() {
-			return nil, errors.New("instance must be stopped to change local volumes")
+	commercialType := d.Get("type").(string)
+	if serverType := getServerType(ctx, api.API, zone, commercialType); serverType != nil {
+		if err := validateScratchVolumes(additionalVolumes, serverType, commercialType); err != nil {
+			return nil, err
 		}
-		volumes[strconv.Itoa(i+1)] = volume.VolumeTemplate()
 	}
 
 	return volumes, nil
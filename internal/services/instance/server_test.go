@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	blockSDK "github.com/scaleway/scaleway-sdk-go/api/block/v1alpha1"
 	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
@@ -1704,6 +1705,49 @@ func TestAccServer_BlockExternal(t *testing.T) {
 	})
 }
 
+func TestAccServer_ExternalVolumes(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      instancechecks.IsServerDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_block_volume" "volume" {
+						iops = 5000
+						size_in_gb = 10
+					}
+
+					resource "scaleway_instance_server" "main" {
+						image = "ubuntu_jammy"
+						type  = "PLAY2-PICO"
+						external_volume_ids = [scaleway_block_volume.volume.id]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					isServerPresent(tt, "scaleway_instance_server.main"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "additional_volume_ids.#", "0"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "external_volume_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("scaleway_instance_server.main", "external_volume_ids.0", "scaleway_block_volume.volume", "id"),
+				),
+			},
+			{
+				// Destroying the server keeps its external volumes, detached
+				Config: `
+					resource "scaleway_block_volume" "volume" {
+						iops = 5000
+						size_in_gb = 10
+					}`,
+				Check: isBlockVolumeDetached(tt, "scaleway_block_volume.volume"),
+			},
+		},
+	})
+}
+
 func TestAccServer_BlockExternalRootVolume(t *testing.T) {
 	tt := acctest.NewTestTools(t)
 	defer tt.Cleanup()
@@ -2053,3 +2097,320 @@ func TestAccServer_PrivateNetworkMissingPNIC(t *testing.T) {
 		},
 	})
 }
+
+func TestAccServer_AdminPasswordEncryption(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      instancechecks.IsServerDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_iam_ssh_key" "admin" {
+					  name       = "tf-tests-instance-server-admin-password"
+					  public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDcMJiYWVemARosR4uIycHqUADepGBhy4MiUdtda/xqYiPT1sFWLrnvK+sVJc9POBGdV0aijd/2irRDAuV9IKRaODOkZq0n6guVFzuk/5YtaBZ3zm4ZlvbMo5ue/cYEBN3KjOjitw2xOuUpBnt5l0GH2jW2AlJ4S5SjgMttVEko8z1i6UBt3GM0PaPwPO5zj7AvFKJU5cCiTLbIocsNvAe3m9pGoSB2POb+VQX5adR7QdeMwQ4cwa4Sh9PF2utp3aQAOW07PSqTUyaYrSOP+crG0zeqk641o6LkAtzLDRoNSgQ/aHimGIpbJrHI5mBUMQBT5kw+k+EBzM8GWuK0GUOd opensource@scaleway.com"
+					}
+
+					resource "scaleway_instance_server" "windows" {
+					  type  = "POP2-2C-8G-WIN"
+					  image = "windows_server_2022"
+
+					  admin_password_encryption_ssh_key_id = scaleway_iam_ssh_key.admin.id
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					isServerPresent(tt, "scaleway_instance_server.windows"),
+					resource.TestCheckResourceAttrPair("scaleway_instance_server.windows", "admin_password_encryption_ssh_key_id", "scaleway_iam_ssh_key.admin", "id"),
+					resource.TestCheckResourceAttrSet("scaleway_instance_server.windows", "admin_password_encrypted_value"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServer_DetachVolumesOnDestroy(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      instancechecks.IsServerDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					provider "scaleway" {
+						features {
+							instance_server {
+								detach_volumes_on_destroy = true
+							}
+						}
+					}
+
+					resource "scaleway_block_volume" "volume" {
+						iops = 5000
+						size_in_gb = 10
+					}
+
+					resource "scaleway_instance_server" "main" {
+						image = "ubuntu_jammy"
+						type  = "PLAY2-PICO"
+						additional_volume_ids = [scaleway_block_volume.volume.id]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					isServerPresent(tt, "scaleway_instance_server.main"),
+					resource.TestCheckResourceAttrPair("scaleway_instance_server.main", "additional_volume_ids.0", "scaleway_block_volume.volume", "id"),
+				),
+			},
+			{
+				// The additional volumes are detached before the server is deleted
+				Config: `
+					provider "scaleway" {
+						features {
+							instance_server {
+								detach_volumes_on_destroy = true
+							}
+						}
+					}
+
+					resource "scaleway_block_volume" "volume" {
+						iops = 5000
+						size_in_gb = 10
+					}`,
+				Check: isBlockVolumeDetached(tt, "scaleway_block_volume.volume"),
+			},
+		},
+	})
+}
+
+func TestAccServer_WaitForCloudInit(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      instancechecks.IsServerDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_vpc_private_network" "main" {
+						name = "test-server-wait-for-cloud-init"
+					}
+
+					resource "scaleway_instance_server" "main" {
+						image = "ubuntu_jammy"
+						type  = "DEV1-S"
+						state = "%s"
+
+						private_network {
+							pn_id = scaleway_vpc_private_network.main.id
+						}
+
+						wait_for_cloud_init = true
+					}`, "stopped"),
+				ExpectError: regexp.MustCompile("wait_for_cloud_init requires the server state to be started"),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_vpc_private_network" "main" {
+						name = "test-server-wait-for-cloud-init"
+					}
+
+					resource "scaleway_instance_server" "main" {
+						image = "ubuntu_jammy"
+						type  = "DEV1-S"
+						state = "%s"
+
+						private_network {
+							pn_id = scaleway_vpc_private_network.main.id
+						}
+
+						wait_for_cloud_init = true
+					}`, "started"),
+				Check: resource.ComposeTestCheckFunc(
+					isServerPresent(tt, "scaleway_instance_server.main"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "state", "started"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "public_ips.#", "0"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "private_network.#", "1"),
+				),
+			},
+			{
+				// The server only waits on creation, it may be stopped afterwards
+				Config: fmt.Sprintf(`
+					resource "scaleway_vpc_private_network" "main" {
+						name = "test-server-wait-for-cloud-init"
+					}
+
+					resource "scaleway_instance_server" "main" {
+						image = "ubuntu_jammy"
+						type  = "DEV1-S"
+						state = "%s"
+
+						private_network {
+							pn_id = scaleway_vpc_private_network.main.id
+						}
+
+						wait_for_cloud_init = true
+					}`, "stopped"),
+				Check: resource.ComposeTestCheckFunc(
+					isServerPresent(tt, "scaleway_instance_server.main"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "state", "stopped"),
+				),
+			},
+		},
+	})
+}
+
+func isBlockVolumeDetached(tt *acctest.TestTools, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		zone, id, err := zonal.ParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		volume, err := blockSDK.NewAPI(tt.Meta.ScwClient()).GetVolume(&blockSDK.GetVolumeRequest{
+			Zone:     zone,
+			VolumeID: id,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(volume.References) > 0 {
+			return fmt.Errorf("volume %s is still attached to %s", rs.Primary.ID, volume.References[0].ProductResourceID)
+		}
+
+		return nil
+	}
+}
+
+func TestAccServer_LocalVolumesSizeOnPlan(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			instancechecks.IsServerDestroyed(tt),
+			isVolumeDestroyed(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_volume" "local" {
+						type       = "l_ssd"
+						size_in_gb = 20
+					}`,
+			},
+			{
+				// DEV1-S local storage cannot hold a 20GB root volume and a 20GB additional volume
+				Config: `
+					provider "scaleway" {
+						features {
+							instance_server {
+								check_local_volumes_size_on_plan = true
+							}
+						}
+					}
+
+					resource "scaleway_instance_volume" "local" {
+						type       = "l_ssd"
+						size_in_gb = 20
+					}
+
+					resource "scaleway_instance_server" "main" {
+						image = "ubuntu_jammy"
+						type  = "DEV1-S"
+						root_volume {
+							volume_type = "l_ssd"
+							size_in_gb  = 20
+						}
+						additional_volume_ids = [scaleway_instance_volume.local.id]
+					}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("DEV1-S total local volume size must be between"),
+			},
+		},
+	})
+}
+
+func TestAccServer_Protected(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      instancechecks.IsServerDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_server" "main" {
+						image     = "ubuntu_jammy"
+						type      = "DEV1-S"
+						protected = true
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					isServerPresent(tt, "scaleway_instance_server.main"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "protected", "true"),
+				),
+			},
+			{
+				Destroy: true,
+				Config: `
+					resource "scaleway_instance_server" "main" {
+						image     = "ubuntu_jammy"
+						type      = "DEV1-S"
+						protected = true
+					}`,
+				ExpectError: regexp.MustCompile("is protected, set protected to false or force_delete to true before deleting it"),
+			},
+			{
+				// The server is still running after the refused deletion
+				Config: `
+					resource "scaleway_instance_server" "main" {
+						image     = "ubuntu_jammy"
+						type      = "DEV1-S"
+						protected = true
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					isServerPresent(tt, "scaleway_instance_server.main"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "state", "started"),
+				),
+			},
+			{
+				// The protection is removed when the server is destroyed at the end of the test
+				Config: `
+					resource "scaleway_instance_server" "main" {
+						image        = "ubuntu_jammy"
+						type         = "DEV1-S"
+						protected    = true
+						force_delete = true
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "protected", "true"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "force_delete", "true"),
+				),
+			},
+		},
+	})
+}
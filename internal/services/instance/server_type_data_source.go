@@ -0,0 +1,107 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+)
+
+func DataSourceServerType() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceServerTypeRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the server type, e.g. DEV1-S",
+			},
+			"availability": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The stock availability of the server type in the zone (available, scarce or shortage)",
+			},
+			"arch": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CPU architecture of the server type",
+			},
+			"cpu": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of CPU cores of the server type",
+			},
+			"ram": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The amount of RAM of the server type in bytes",
+			},
+			"gpu": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of GPUs of the server type",
+			},
+			"hourly_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The hourly price of the server type in euros",
+			},
+			"zone": zonal.Schema(),
+		},
+	}
+}
+
+func DataSourceServerTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, zone, err := newAPIWithZone(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+
+	serverTypes, err := api.ListServersTypes(&instance.ListServersTypesRequest{
+		Zone: zone,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serverType, exists := serverTypes.Servers[name]
+	if !exists {
+		return diag.FromErr(fmt.Errorf("server type %s not found in zone %s", name, zone))
+	}
+
+	availabilities, err := api.GetServerTypesAvailability(&instance.GetServerTypesAvailabilityRequest{
+		Zone: zone,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Server types missing from the availability list cannot be ordered anymore.
+	availability := instance.ServerTypesAvailabilityShortage
+	if serverTypeAvailability, ok := availabilities.Servers[name]; ok {
+		availability = serverTypeAvailability.Availability
+	}
+
+	gpu := 0
+	if serverType.Gpu != nil {
+		gpu = int(*serverType.Gpu)
+	}
+
+	d.SetId(zonal.NewIDString(zone, name))
+	_ = d.Set("name", name)
+	_ = d.Set("availability", availability.String())
+	_ = d.Set("arch", serverType.Arch.String())
+	_ = d.Set("cpu", int(serverType.Ncpus))
+	_ = d.Set("ram", int(serverType.RAM))
+	_ = d.Set("gpu", gpu)
+	_ = d.Set("hourly_price", float64(serverType.HourlyPrice))
+	_ = d.Set("zone", zone.String())
+
+	return nil
+}
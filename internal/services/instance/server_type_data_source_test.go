@@ -0,0 +1,55 @@
+package instance_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceServerType_Basic(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "scaleway_instance_server_type" "dev" {
+						name = "DEV1-S"
+						zone = "fr-par-1"
+					}
+
+					data "scaleway_instance_server_type" "gpu" {
+						name = "GPU-3070-S"
+						zone = "fr-par-2"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_instance_server_type.dev", "id", "fr-par-1/DEV1-S"),
+					resource.TestMatchResourceAttr("data.scaleway_instance_server_type.dev", "availability", regexp.MustCompile("^(available|scarce|shortage)$")),
+					resource.TestCheckResourceAttr("data.scaleway_instance_server_type.dev", "arch", "x86_64"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_server_type.dev", "cpu", "2"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_server_type.dev", "ram", "2147483648"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_server_type.dev", "gpu", "0"),
+					resource.TestCheckResourceAttrSet("data.scaleway_instance_server_type.dev", "hourly_price"),
+
+					resource.TestMatchResourceAttr("data.scaleway_instance_server_type.gpu", "availability", regexp.MustCompile("^(available|scarce|shortage)$")),
+					resource.TestCheckResourceAttr("data.scaleway_instance_server_type.gpu", "gpu", "1"),
+				),
+			},
+			{
+				Config: `
+					data "scaleway_instance_server_type" "unknown" {
+						name = "UNKNOWN"
+						zone = "fr-par-1"
+					}`,
+				ExpectError: regexp.MustCompile("server type UNKNOWN not found in zone fr-par-1"),
+			},
+		},
+	})
+}
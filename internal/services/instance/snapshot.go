@@ -47,7 +47,7 @@ func ResourceSnapshot() *schema.Resource {
 				Optional:         true,
 				ForceNew:         true,
 				Description:      "ID of the volume to take a snapshot from",
-				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithZone(),
 				ConflictsWith:    []string{"import"},
 			},
 			"type": {
@@ -56,6 +56,9 @@ func testSweepVolume(_ string) error {
 
 		for _, volume := range listVolumesResponse.Volumes {
 			if volume.Server == nil {
+				if !acctest.ShouldSweep("scaleway_instance_volume", volume.ID, volume.Name, volume.Tags) {
+					continue
+				}
 				err := instanceAPI.DeleteVolume(&instanceSDK.DeleteVolumeRequest{
 					Zone:     zone,
 					VolumeID: volume.ID,
@@ -82,6 +85,9 @@ func testSweepSnapshot(_ string) error {
 		}
 
 		for _, snapshot := range listSnapshotsResponse.Snapshots {
+			if !acctest.ShouldSweep("scaleway_instance_snapshot", snapshot.ID, snapshot.Name, snapshot.Tags) {
+				continue
+			}
 			err := api.DeleteSnapshot(&instanceSDK.DeleteSnapshotRequest{
 				Zone:       zone,
 				SnapshotID: snapshot.ID,
@@ -106,6 +112,9 @@ func testSweepServer(_ string) error {
 		}
 
 		for _, srv := range listServers.Servers {
+			if !acctest.ShouldSweep("scaleway_instance_server", srv.ID, srv.Name, srv.Tags) {
+				continue
+			}
 			if srv.State == instanceSDK.ServerStateStopped || srv.State == instanceSDK.ServerStateStoppedInPlace {
 				err := instanceAPI.DeleteServer(&instanceSDK.DeleteServerRequest{
 					Zone:     zone,
@@ -148,6 +157,9 @@ func testSweepSecurityGroup(_ string) error {
 			if securityGroup.ProjectDefault {
 				continue
 			}
+			if !acctest.ShouldSweep("scaleway_instance_security_group", securityGroup.ID, securityGroup.Name, securityGroup.Tags) {
+				continue
+			}
 			err = instanceAPI.DeleteSecurityGroup(&instanceSDK.DeleteSecurityGroupRequest{
 				Zone:            zone,
 				SecurityGroupID: securityGroup.ID,
@@ -174,6 +186,9 @@ func testSweepPlacementGroup(_ string) error {
 		}
 
 		for _, pg := range listPlacementGroups.PlacementGroups {
+			if !acctest.ShouldSweep("scaleway_instance_placement_group", pg.ID, pg.Name, pg.Tags) {
+				continue
+			}
 			err := instanceAPI.DeletePlacementGroup(&instanceSDK.DeletePlacementGroupRequest{
 				Zone:             zone,
 				PlacementGroupID: pg.ID,
@@ -198,6 +213,9 @@ func testSweepIP(_ string) error {
 		}
 
 		for _, ip := range listIPs.IPs {
+			if !acctest.ShouldSweep("scaleway_instance_ip", ip.ID, ip.Address.String(), ip.Tags) {
+				continue
+			}
 			err := instanceAPI.DeleteIP(&instanceSDK.DeleteIPRequest{
 				IP:   ip.ID,
 				Zone: zone,
@@ -225,6 +243,9 @@ func testSweepImage(_ string) error {
 		}
 
 		for _, image := range listImagesResponse.Images {
+			if !acctest.ShouldSweep("scaleway_instance_image", image.ID, image.Name, image.Tags) {
+				continue
+			}
 			err := api.DeleteImage(&instanceSDK.DeleteImageRequest{
 				Zone:    zone,
 				ImageID: image.ID,
@@ -78,6 +78,17 @@ func flattenImageExtraVolumes(volumes map[string]*instance.Volume, zone scw.Zone
 	return volumesFlat
 }
 
+// imageExtraVolumesSnapshotIDs returns the snapshot IDs of the extra volumes of an image, ordered by their index starting at 1.
+func imageExtraVolumesSnapshotIDs(volumes map[string]*instance.Volume) []string {
+	snapshotIDs := make([]string, 0, len(volumes))
+	for i := 1; i <= len(volumes); i++ {
+		if volume, ok := volumes[strconv.Itoa(i)]; ok {
+			snapshotIDs = append(snapshotIDs, volume.ID)
+		}
+	}
+	return snapshotIDs
+}
+
 func flattenServerPublicIPs(zone scw.Zone, ips []*instance.ServerIP) []interface{} {
 	flattenedIPs := make([]interface{}, len(ips))
 
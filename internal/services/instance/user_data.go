@@ -63,6 +63,9 @@ func ResourceInstanceUserDataCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	serverID := locality.ExpandID(d.Get("server_id").(string))
+	unlock := lockServer(zone, serverID)
+	defer unlock()
+
 	server, err := waitForServer(ctx, instanceAPI, zone, serverID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
@@ -141,6 +144,9 @@ func ResourceInstanceUserDataUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	unlock := lockServer(zone, id)
+	defer unlock()
+
 	server, err := waitForServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return diag.FromErr(err)
@@ -180,6 +186,9 @@ func ResourceInstanceUserDataDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	unlock := lockServer(zone, id)
+	defer unlock()
+
 	deleteUserData := &instanceSDK.DeleteServerUserDataRequest{
 		ServerID: locality.ExpandID(id),
 		Key:      key,
@@ -10,6 +10,7 @@ import (
 	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/console"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
@@ -59,7 +60,7 @@ func ResourceVolume() *schema.Resource {
 				Optional:         true,
 				ForceNew:         true,
 				Description:      "Create a volume based on a image",
-				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithZone(),
 				ConflictsWith:    []string{"size_in_gb"},
 			},
 			"server_id": {
@@ -76,6 +77,7 @@ func ResourceVolume() *schema.Resource {
 				Description: "The tags associated with the volume",
 			},
 			"organization_id": account.OrganizationIDSchema(),
+			"console_url":     console.URLSchema(),
 			"project_id":      account.ProjectIDSchema(),
 			"zone":            zonal.Schema(),
 		},
@@ -151,6 +153,7 @@ func ResourceInstanceVolumeRead(ctx context.Context, d *schema.ResourceData, m i
 	_ = d.Set("organization_id", res.Volume.Organization)
 	_ = d.Set("project_id", res.Volume.Project)
 	_ = d.Set("zone", string(zone))
+	_ = d.Set("console_url", console.URL("instance/volumes", zone.String(), res.Volume.ID))
 	_ = d.Set("type", res.Volume.VolumeType.String())
 	_ = d.Set("tags", res.Volume.Tags)
 
@@ -2,8 +2,10 @@ package instance
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
@@ -105,3 +107,75 @@ func waitForImage(ctx context.Context, api *instance.API, zone scw.Zone, id stri
 
 	return image, err
 }
+
+func waitForServerRDPPassword(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) (*instance.Server, error) {
+	retryInterval := defaultInstanceRetryInterval
+	if transport.DefaultWaitRetryInterval != nil {
+		retryInterval = *transport.DefaultWaitRetryInterval
+	}
+
+	server, err := api.WaitForServerRDPPassword(&instance.WaitForServerRDPPasswordRequest{
+		Zone:          zone,
+		ServerID:      id,
+		Timeout:       scw.TimeDurationPtr(timeout),
+		RetryInterval: &retryInterval,
+	}, scw.WithContext(ctx))
+
+	return server, err
+}
+
+// waitForServerBooted waits for the running server to report the end of its boot in its state detail
+func waitForServerBooted(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		res, err := api.GetServer(&instance.GetServerRequest{
+			Zone:     zone,
+			ServerID: id,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if res.Server.State != instance.ServerStateRunning {
+			return retry.NonRetryableError(fmt.Errorf("server %s is %s while waiting for its boot", id, res.Server.State))
+		}
+
+		if res.Server.StateDetail != instanceServerStateDetailBooted {
+			return retry.RetryableError(fmt.Errorf("server %s has not finished booting (%s)", id, res.Server.StateDetail))
+		}
+
+		return nil
+	})
+}
+
+// waitForIPAttachment waits for the IP to be attached to the given server, or detached when serverID is empty
+func waitForIPAttachment(ctx context.Context, api *instance.API, zone scw.Zone, ipID string, serverID string, timeout time.Duration) (*instance.IP, error) {
+	var ip *instance.IP
+
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		res, err := api.GetIP(&instance.GetIPRequest{
+			Zone: zone,
+			IP:   ipID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		ip = res.IP
+
+		if ip.State == instance.IPStateError {
+			return retry.NonRetryableError(fmt.Errorf("ip %s is in error state", ipID))
+		}
+
+		attachedServerID := ""
+		if ip.Server != nil {
+			attachedServerID = ip.Server.ID
+		}
+
+		if ip.State == instance.IPStatePending || attachedServerID != serverID {
+			return retry.RetryableError(fmt.Errorf("ip %s is attached to %q, waiting for %q", ipID, attachedServerID, serverID))
+		}
+
+		return nil
+	})
+
+	return ip, err
+}
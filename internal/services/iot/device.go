@@ -6,6 +6,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/api/iot/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
@@ -124,6 +126,38 @@ func ResourceDevice() *schema.Resource {
 					},
 				},
 			},
+			"twin_document": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Twin documents of the device",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the twin document",
+						},
+						"data": {
+							Type:             schema.TypeString,
+							Required:         true,
+							Description:      "The JSON content of the twin document",
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+						},
+						"merge": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Merge data into the current document instead of replacing it, keeping the properties set by the device",
+						},
+						"version": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The version of the twin document",
+						},
+					},
+				},
+			},
 			// Provided or computed elements
 			"certificate": {
 				Type:        schema.TypeList,
@@ -268,6 +302,11 @@ func ResourceIotDeviceCreate(ctx context.Context, d *schema.ResourceData, m inte
 		_ = d.Set("certificate", []map[string]interface{}{cert})
 	}
 
+	err = updateDeviceTwinDocuments(ctx, iotAPI, region, res.Device.ID, nil, d.Get("twin_document").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return ResourceIotDeviceRead(ctx, d, m)
 }
 
@@ -353,6 +392,12 @@ func ResourceIotDeviceRead(ctx context.Context, d *schema.ResourceData, m interf
 		_ = d.Set("certificate", []map[string]interface{}{cert})
 	}
 
+	twinDocuments, err := readDeviceTwinDocuments(ctx, iotAPI, region, deviceID, d.Get("twin_document").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("twin_document", twinDocuments)
+
 	return nil
 }
 
@@ -430,6 +475,14 @@ func ResourceIotDeviceUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		}
 	}
 
+	if d.HasChange("twin_document") {
+		oldDocuments, newDocuments := d.GetChange("twin_document")
+		err = updateDeviceTwinDocuments(ctx, iotAPI, region, deviceID, oldDocuments.([]interface{}), newDocuments.([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return ResourceIotDeviceRead(ctx, d, m)
 }
 
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	iotSDK "github.com/scaleway/scaleway-sdk-go/api/iot/v1"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/iot"
 )
 
@@ -242,3 +243,110 @@ func isDevicePresent(tt *acctest.TestTools, n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestAccDevice_TwinDocuments(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		// Destruction is done via the hub destruction.
+		CheckDestroy: isHubDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+						resource "scaleway_iot_device" "twin" {
+							name = "twin"
+							hub_id = scaleway_iot_hub.twin.id
+							twin_document {
+								name = "unchanged"
+								data = jsonencode({ value = "old" })
+							}
+							twin_document {
+								name = "changed"
+								data = jsonencode({ value = "old" })
+							}
+							twin_document {
+								name = "removed"
+								data = jsonencode({ value = "old" })
+							}
+						}
+						resource "scaleway_iot_hub" "twin" {
+							name = "twin"
+							product_plan = "plan_shared"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					isDevicePresent(tt, "scaleway_iot_device.twin"),
+					resource.TestCheckResourceAttr("scaleway_iot_device.twin", "twin_document.#", "3"),
+					resource.TestCheckResourceAttr("scaleway_iot_device.twin", "twin_document.0.version", "1"),
+					resource.TestCheckResourceAttr("scaleway_iot_device.twin", "twin_document.1.version", "1"),
+					resource.TestCheckResourceAttr("scaleway_iot_device.twin", "twin_document.2.version", "1"),
+				),
+			},
+			{
+				// Only the documents that changed are written, a reformatted document is not a change
+				Config: `
+						resource "scaleway_iot_device" "twin" {
+							name = "twin"
+							hub_id = scaleway_iot_hub.twin.id
+							twin_document {
+								name = "unchanged"
+								data = "{ \"value\": \"old\" }"
+							}
+							twin_document {
+								name = "changed"
+								data = jsonencode({ value = "new" })
+							}
+							twin_document {
+								name = "added"
+								data = jsonencode({ value = "new" })
+							}
+						}
+						resource "scaleway_iot_hub" "twin" {
+							name = "twin"
+							product_plan = "plan_shared"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					isDevicePresent(tt, "scaleway_iot_device.twin"),
+					resource.TestCheckResourceAttr("scaleway_iot_device.twin", "twin_document.#", "3"),
+					resource.TestCheckResourceAttr("scaleway_iot_device.twin", "twin_document.0.version", "1"),
+					resource.TestCheckResourceAttr("scaleway_iot_device.twin", "twin_document.1.version", "2"),
+					resource.TestCheckResourceAttr("scaleway_iot_device.twin", "twin_document.2.name", "added"),
+					resource.TestCheckResourceAttr("scaleway_iot_device.twin", "twin_document.2.version", "1"),
+					isTwinDocumentAbsent(tt, "scaleway_iot_device.twin", "removed"),
+				),
+			},
+		},
+	})
+}
+
+func isTwinDocumentAbsent(tt *acctest.TestTools, n string, documentName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		iotAPI, region, deviceID, err := iot.NewAPIWithRegionAndID(tt.Meta, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = iotAPI.GetTwinDocument(&iotSDK.GetTwinDocumentRequest{
+			Region:       region,
+			TwinID:       deviceID,
+			DocumentName: documentName,
+		})
+		if err == nil {
+			return fmt.Errorf("twin document %s of device %s still exists", documentName, deviceID)
+		}
+		if !httperrors.Is404(err) {
+			return err
+		}
+
+		return nil
+	}
+}
@@ -2,13 +2,17 @@ package iot
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/scaleway/scaleway-sdk-go/api/iot/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
@@ -84,3 +88,134 @@ func computeIotHubMQTTCa(ctx context.Context, mqttCaURL string, m interface{}) (
 	resp, _ := io.ReadAll(mqttCa.Body)
 	return string(resp), nil
 }
+
+// customizeDiffDeviceAutoProvisioning ensures device auto provisioning is only enabled on hubs with a custom certificate authority
+func customizeDiffDeviceAutoProvisioning(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.Get("device_auto_provisioning").(bool) {
+		return nil
+	}
+
+	// The certificate authority may come from another resource, it can only be checked once known
+	if !diff.NewValueKnown("hub_ca") {
+		return nil
+	}
+
+	if diff.Get("hub_ca").(string) == "" {
+		return errors.New("device_auto_provisioning can only be enabled on a hub with a custom certificate authority, please set hub_ca and hub_ca_challenge")
+	}
+
+	return nil
+}
+
+// updateDeviceTwinDocuments deletes the twin documents removed from the configuration, then replaces or merges the changed ones.
+// Unchanged documents are left untouched so their version is kept. The twin of a device shares its ID.
+func updateDeviceTwinDocuments(ctx context.Context, api *iot.API, region scw.Region, deviceID string, oldDocuments []interface{}, newDocuments []interface{}) error {
+	newDocumentsByName := make(map[string]map[string]interface{}, len(newDocuments))
+	for _, raw := range newDocuments {
+		document := raw.(map[string]interface{})
+		newDocumentsByName[document["name"].(string)] = document
+	}
+	oldDocumentsByName := make(map[string]map[string]interface{}, len(oldDocuments))
+
+	for _, raw := range oldDocuments {
+		document := raw.(map[string]interface{})
+		name := document["name"].(string)
+		oldDocumentsByName[name] = document
+		if _, kept := newDocumentsByName[name]; kept {
+			continue
+		}
+
+		err := api.DeleteTwinDocument(&iot.DeleteTwinDocumentRequest{
+			Region:       region,
+			TwinID:       deviceID,
+			DocumentName: name,
+		}, scw.WithContext(ctx))
+		if err != nil && !httperrors.Is404(err) {
+			return fmt.Errorf("failed to delete twin document %s: %w", name, err)
+		}
+	}
+
+	for _, raw := range newDocuments {
+		document := raw.(map[string]interface{})
+		name := document["name"].(string)
+
+		if oldDocument, exists := oldDocumentsByName[name]; exists && twinDocumentUnchanged(oldDocument, document) {
+			continue
+		}
+
+		data, err := scw.DecodeJSONObject(document["data"].(string), scw.NoEscape)
+		if err != nil {
+			return fmt.Errorf("invalid data of twin document %s: %w", name, err)
+		}
+
+		if document["merge"].(bool) {
+			_, err = api.PatchTwinDocument(&iot.PatchTwinDocumentRequest{
+				Region:       region,
+				TwinID:       deviceID,
+				DocumentName: name,
+				Data:         &data,
+			}, scw.WithContext(ctx))
+		} else {
+			_, err = api.PutTwinDocument(&iot.PutTwinDocumentRequest{
+				Region:       region,
+				TwinID:       deviceID,
+				DocumentName: name,
+				Data:         &data,
+			}, scw.WithContext(ctx))
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update twin document %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// twinDocumentUnchanged reports whether the document is applied the same way, with the same data
+func twinDocumentUnchanged(oldDocument map[string]interface{}, newDocument map[string]interface{}) bool {
+	if oldDocument["merge"].(bool) != newDocument["merge"].(bool) {
+		return false
+	}
+
+	return structure.SuppressJsonDiff("data", oldDocument["data"].(string), newDocument["data"].(string), nil)
+}
+
+// readDeviceTwinDocuments returns the managed twin documents, merged documents keep their configured data
+// as the device may have added its own properties.
+func readDeviceTwinDocuments(ctx context.Context, api *iot.API, region scw.Region, deviceID string, documents []interface{}) ([]interface{}, error) {
+	twinDocuments := make([]interface{}, 0, len(documents))
+
+	for _, raw := range documents {
+		document := raw.(map[string]interface{})
+		name := document["name"].(string)
+
+		twinDocument, err := api.GetTwinDocument(&iot.GetTwinDocumentRequest{
+			Region:       region,
+			TwinID:       deviceID,
+			DocumentName: name,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			if httperrors.Is404(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read twin document %s: %w", name, err)
+		}
+
+		data := document["data"]
+		if !document["merge"].(bool) && twinDocument.Data != nil {
+			data, err = scw.EncodeJSONObject(*twinDocument.Data, scw.NoEscape)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		twinDocuments = append(twinDocuments, map[string]interface{}{
+			"name":    twinDocument.DocumentName,
+			"data":    data,
+			"merge":   document["merge"],
+			"version": int(twinDocument.Version),
+		})
+	}
+
+	return twinDocuments, nil
+}
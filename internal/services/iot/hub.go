@@ -61,9 +61,10 @@ func ResourceHub() *schema.Resource {
 				Default:     "$SCW/events",
 			},
 			"hub_ca": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Custom user provided certificate authority",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Custom user provided certificate authority",
+				RequiredWith: []string{"hub_ca_challenge"},
 			},
 			"hub_ca_challenge": {
 				Type:         schema.TypeString,
@@ -76,6 +77,11 @@ func ResourceHub() *schema.Resource {
 				Optional:    true,
 				Description: "Wether to enable the device auto provisioning or not",
 			},
+			"has_custom_ca": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Wether the hub is using a custom user provided certificate authority",
+			},
 
 			// Computed elements
 			"region":          regional.Schema(),
@@ -122,6 +128,7 @@ func ResourceHub() *schema.Resource {
 				Description: "The current number of connected devices in the Hub",
 			},
 		},
+		CustomizeDiff: customizeDiffDeviceAutoProvisioning,
 	}
 }
 
@@ -173,8 +180,10 @@ func ResourceIotHubCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	}
 
 	// Now user CA is set, set device auto provisioning if needed.
-	if devProv, ok := d.GetOk("device_autoprovisioning"); ok {
+	if devProv, ok := d.GetOk("device_auto_provisioning"); ok {
 		_, err = iotAPI.UpdateHub(&iot.UpdateHubRequest{
+			Region:                       region,
+			HubID:                        res.ID,
 			EnableDeviceAutoProvisioning: scw.BoolPtr(devProv.(bool)),
 		}, scw.WithContext(ctx))
 		if err != nil {
@@ -239,6 +248,7 @@ func ResourceIotHubRead(ctx context.Context, d *schema.ResourceData, m interface
 	_ = d.Set("disable_events", response.DisableEvents)
 	_ = d.Set("events_topic_prefix", response.EventsTopicPrefix)
 	_ = d.Set("device_auto_provisioning", response.EnableDeviceAutoProvisioning)
+	_ = d.Set("has_custom_ca", response.HasCustomCa)
 	_ = d.Set("mqtt_ca_url", computeIotHubCaURL(response.ProductPlan, region))
 	mqttURL := d.Get("mqtt_ca_url")
 	mqttCa, err := computeIotHubMQTTCa(ctx, fmt.Sprintf("%v", mqttURL), m)
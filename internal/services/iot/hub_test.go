@@ -1,6 +1,7 @@
 package iot_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/iot"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccHub_Minimal(t *testing.T) {
@@ -161,3 +163,62 @@ func isHubPresent(tt *acctest.TestTools, n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestHub_DeviceAutoProvisioning(t *testing.T) {
+	// Value set by Terraform for attributes known after apply
+	const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+	tests := []struct {
+		name        string
+		config      map[string]interface{}
+		expectedErr string
+	}{
+		{
+			name: "Without auto provisioning",
+			config: map[string]interface{}{
+				"name":         "hub",
+				"product_plan": "plan_dedicated",
+			},
+		},
+		{
+			name: "Without CA",
+			config: map[string]interface{}{
+				"name":                     "hub",
+				"product_plan":             "plan_dedicated",
+				"device_auto_provisioning": true,
+			},
+			expectedErr: "device_auto_provisioning can only be enabled on a hub with a custom certificate authority",
+		},
+		{
+			name: "With CA",
+			config: map[string]interface{}{
+				"name":                     "hub",
+				"product_plan":             "plan_dedicated",
+				"device_auto_provisioning": true,
+				"hub_ca":                   "-----BEGIN CERTIFICATE-----",
+				"hub_ca_challenge":         "-----BEGIN CERTIFICATE-----",
+			},
+		},
+		{
+			name: "With CA known after apply",
+			config: map[string]interface{}{
+				"name":                     "hub",
+				"product_plan":             "plan_dedicated",
+				"device_auto_provisioning": true,
+				"hub_ca":                   unknownValue,
+				"hub_ca_challenge":         unknownValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := iot.ResourceHub().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tt.config), nil)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -29,6 +29,9 @@ func testSweepHub(_ string) error {
 
 		deleteDevices := true
 		for _, hub := range listHubs.Hubs {
+			if !acctest.ShouldSweep("scaleway_iot_hub", hub.ID, hub.Name, nil) {
+				continue
+			}
 			err := iotAPI.DeleteHub(&iotSDK.DeleteHubRequest{
 				HubID:         hub.ID,
 				Region:        hub.Region,
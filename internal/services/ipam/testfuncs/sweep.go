@@ -29,6 +29,9 @@ func testSweepIPAMIP(_ string) error {
 		}
 
 		for _, v := range listIPs.IPs {
+			if !acctest.ShouldSweep("scaleway_ipam_ip", v.ID, v.Address.String(), v.Tags) {
+				continue
+			}
 			err := ipamAPI.ReleaseIP(&ipamSDK.ReleaseIPRequest{
 				IPID:   v.ID,
 				Region: region,
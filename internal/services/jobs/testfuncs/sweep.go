@@ -30,6 +30,9 @@ func testSweepJobDefinition(_ string) error {
 		}
 
 		for _, definition := range listJobDefinitions.JobDefinitions {
+			if !acctest.ShouldSweep("scaleway_job_definition", definition.ID, definition.Name, nil) {
+				continue
+			}
 			err := jobsAPI.DeleteJobDefinition(&jobsSDK.DeleteJobDefinitionRequest{
 				JobDefinitionID: definition.ID,
 				Region:          region,
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/console"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
@@ -62,6 +63,19 @@ func ResourceCluster() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				Description: "The version of the cluster",
+				DiffSuppressFunc: func(_, oldValue, newValue string, d *schema.ResourceData) bool {
+					if !d.Get("ignore_minor_version_drift").(bool) || !d.Get("auto_upgrade.0.enable").(bool) {
+						return false
+					}
+
+					return IsMinorVersionDrift(oldValue, newValue)
+				},
+			},
+			"ignore_minor_version_drift": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Ignore the cluster running a newer minor version than the configured one when auto upgrade is enabled",
 			},
 			"cni": {
 				Type:             schema.TypeString,
@@ -160,6 +174,7 @@ func ResourceCluster() *schema.Resource {
 			},
 			"region":          regional.Schema(),
 			"organization_id": account.OrganizationIDSchema(),
+			"console_url":     console.URLSchema(),
 			"project_id":      account.ProjectIDSchema(),
 			// Computed elements
 			"created_at": {
@@ -303,6 +318,7 @@ func ResourceCluster() *schema.Resource {
 				}
 				return nil
 			},
+			customizeDiffK8SClusterAdmissionPlugins,
 		),
 	}
 }
@@ -530,6 +546,7 @@ func ResourceK8SClusterRead(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	_ = d.Set("region", string(region))
+	_ = d.Set("console_url", console.URL("kapsule/clusters", region.String(), cluster.ID))
 	_ = d.Set("name", cluster.Name)
 	_ = d.Set("type", cluster.Type)
 	_ = d.Set("organization_id", cluster.OrganizationID)
@@ -605,7 +622,7 @@ func ResourceK8SClusterUpdate(ctx context.Context, d *schema.ResourceData, m int
 	}
 
 	if d.HasChange("type") {
-		_, err = waitCluster(ctx, k8sAPI, region, clusterID, defaultK8SClusterTimeout)
+		_, err = waitCluster(ctx, k8sAPI, region, clusterID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -618,7 +635,7 @@ func ResourceK8SClusterUpdate(ctx context.Context, d *schema.ResourceData, m int
 			return diag.FromErr(err)
 		}
 		// We have to wait for the pools to reach a stable state too (e.g. being detached from the private network)
-		_, err = waitClusterPool(ctx, k8sAPI, region, clusterID, defaultK8SClusterTimeout)
+		_, err = waitClusterPool(ctx, k8sAPI, region, clusterID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -0,0 +1,98 @@
+package k8s
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func DataSourceClusterKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceK8SClusterKubeconfigRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The ID of the cluster",
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithRegion(),
+			},
+			"config_file": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The whole kubeconfig file",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The kubernetes master URL",
+			},
+			"cluster_ca_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The kubernetes cluster CA certificate",
+			},
+			"cluster_ca_certificate_expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the expiration of the kubernetes cluster CA certificate",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The kubernetes cluster admin token",
+			},
+			"region": regional.Schema(),
+		},
+	}
+}
+
+func DataSourceK8SClusterKubeconfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k8sAPI, region, err := newAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	regionalizedID := datasource.NewRegionalID(d.Get("cluster_id"), region)
+	region, clusterID, err := regional.ParseID(regionalizedID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	kubeconfig, err := flattenKubeconfig(ctx, k8sAPI, region, clusterID)
+	if err != nil {
+		if httperrors.Is403(err) {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Cannot read kubeconfig: unauthorized",
+				Detail:        "Got 403 while reading kubeconfig, please check your permissions",
+				AttributePath: cty.GetAttrPath("cluster_id"),
+			}}
+		}
+		return diag.FromErr(err)
+	}
+
+	caExpiresAt, err := kubeconfigCertificateExpiresAt(kubeconfig["cluster_ca_certificate"].(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(regionalizedID)
+	_ = d.Set("cluster_id", regionalizedID)
+	_ = d.Set("region", region)
+	_ = d.Set("config_file", kubeconfig["config_file"])
+	_ = d.Set("host", kubeconfig["host"])
+	_ = d.Set("cluster_ca_certificate", kubeconfig["cluster_ca_certificate"])
+	_ = d.Set("cluster_ca_certificate_expires_at", types.FlattenTime(caExpiresAt))
+	_ = d.Set("token", kubeconfig["token"])
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package k8s_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	vpcchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc/testfuncs"
+)
+
+func TestAccDataSourceClusterKubeconfig_Basic(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	version := testAccK8SClusterGetLatestK8SVersion(tt)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckK8SClusterDestroy(tt),
+			vpcchecks.CheckPrivateNetworkDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_vpc_private_network" "main" {
+						name = "test-data-source-cluster-kubeconfig"
+					}
+
+					resource "scaleway_k8s_cluster" "main" {
+						name    = "tf-cluster-kubeconfig"
+						version = "%s"
+						cni     = "cilium"
+						tags    = [ "terraform-test", "data_scaleway_k8s_cluster_kubeconfig", "basic" ]
+						delete_additional_resources = false
+						private_network_id = scaleway_vpc_private_network.main.id
+					}
+
+					data "scaleway_k8s_cluster_kubeconfig" "main" {
+						cluster_id = scaleway_k8s_cluster.main.id
+					}`, version),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.scaleway_k8s_cluster_kubeconfig.main", "id", "scaleway_k8s_cluster.main", "id"),
+					resource.TestCheckResourceAttrPair("data.scaleway_k8s_cluster_kubeconfig.main", "host", "scaleway_k8s_cluster.main", "kubeconfig.0.host"),
+					resource.TestCheckResourceAttrPair("data.scaleway_k8s_cluster_kubeconfig.main", "cluster_ca_certificate", "scaleway_k8s_cluster.main", "kubeconfig.0.cluster_ca_certificate"),
+					resource.TestCheckResourceAttrPair("data.scaleway_k8s_cluster_kubeconfig.main", "token", "scaleway_k8s_cluster.main", "kubeconfig.0.token"),
+					resource.TestCheckResourceAttrSet("data.scaleway_k8s_cluster_kubeconfig.main", "config_file"),
+					resource.TestCheckResourceAttrSet("data.scaleway_k8s_cluster_kubeconfig.main", "cluster_ca_certificate_expires_at"),
+				),
+			},
+		},
+	})
+}
@@ -2,11 +2,19 @@ package k8s
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -21,6 +29,15 @@ const (
 	defaultK8SRetryInterval  = 5 * time.Second
 )
 
+const (
+	// poolTagLabelPrefix is the pool tag prefix applying a label without the k8s.scaleway.com/ prefix to the nodes
+	poolTagLabelPrefix = "noprefix="
+	// poolTagTaintPrefix is the pool tag prefix applying a taint without the k8s.scaleway.com/ prefix to the nodes
+	poolTagTaintPrefix = "taint=noprefix="
+)
+
+var poolTaintEffects = []string{"NoSchedule", "PreferNoSchedule", "NoExecute"}
+
 func newAPIWithRegion(d *schema.ResourceData, m interface{}) (*k8s.API, scw.Region, error) {
 	k8sAPI := k8s.NewAPI(meta.ExtractScwClient(m))
 
@@ -50,6 +67,44 @@ func GetMinorVersionFromFull(version string) (string, error) {
 	return versionSplit[0] + "." + versionSplit[1], nil
 }
 
+// IsMinorVersionDrift returns true if the current version runs a newer minor version than the planned one,
+// as done by the auto upgrade when a minor version reaches its end of support.
+func IsMinorVersionDrift(currentVersion, plannedVersion string) bool {
+	currentMajor, currentMinor, err := parseMinorVersion(currentVersion)
+	if err != nil {
+		return false
+	}
+	plannedMajor, plannedMinor, err := parseMinorVersion(plannedVersion)
+	if err != nil {
+		return false
+	}
+
+	if currentMajor != plannedMajor {
+		return currentMajor > plannedMajor
+	}
+
+	return currentMinor > plannedMinor
+}
+
+// parseMinorVersion returns the major and minor numbers of a x.y or x.y.z version
+func parseMinorVersion(version string) (int, int, error) {
+	versionSplit := strings.Split(version, ".")
+	if len(versionSplit) < 2 {
+		return 0, 0, fmt.Errorf("version %q is not a x.y or x.y.z version", version)
+	}
+
+	major, err := strconv.Atoi(versionSplit[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	minor, err := strconv.Atoi(versionSplit[1])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return major, minor, nil
+}
+
 // k8sGetLatestVersionFromMinor returns the latest full version (x.y.z) for a given minor version (x.y)
 func k8sGetLatestVersionFromMinor(ctx context.Context, k8sAPI *k8s.API, region scw.Region, version string) (string, error) {
 	versionSplit := strings.Split(version, ".")
@@ -108,3 +163,170 @@ func getNodes(ctx context.Context, k8sAPI *k8s.API, pool *k8s.Pool) ([]map[strin
 
 	return convertNodes(nodes), nil
 }
+
+// ExpandPoolTags merges the pool tags with the node labels and taints, which are applied to the nodes through specially formatted tags
+func ExpandPoolTags(tags []string, labels map[string]string, taints map[string]string) []string {
+	poolTags := append([]string{}, tags...)
+
+	labelKeys := make([]string, 0, len(labels))
+	for key := range labels {
+		labelKeys = append(labelKeys, key)
+	}
+	sort.Strings(labelKeys)
+
+	for _, key := range labelKeys {
+		poolTags = append(poolTags, poolTagLabelPrefix+key+"="+labels[key])
+	}
+
+	taintKeys := make([]string, 0, len(taints))
+	for key := range taints {
+		taintKeys = append(taintKeys, key)
+	}
+	sort.Strings(taintKeys)
+
+	for _, key := range taintKeys {
+		poolTags = append(poolTags, poolTagTaintPrefix+key+"="+taints[key])
+	}
+
+	return poolTags
+}
+
+// FlattenPoolTags splits the pool tags into plain tags, node labels and node taints.
+// Tags already present in stateTags are kept as plain tags so that labels or taints declared in tags do not drift.
+func FlattenPoolTags(poolTags []string, stateTags []string) ([]string, map[string]interface{}, map[string]interface{}) {
+	knownTags := make(map[string]bool, len(stateTags))
+	for _, tag := range stateTags {
+		knownTags[tag] = true
+	}
+
+	tags := []string(nil)
+	labels := map[string]interface{}{}
+	taints := map[string]interface{}{}
+
+	for _, tag := range poolTags {
+		if knownTags[tag] {
+			tags = append(tags, tag)
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(tag, poolTagTaintPrefix):
+			key, value, found := strings.Cut(strings.TrimPrefix(tag, poolTagTaintPrefix), "=")
+			if found {
+				taints[key] = value
+				continue
+			}
+		case strings.HasPrefix(tag, poolTagLabelPrefix):
+			key, value, found := strings.Cut(strings.TrimPrefix(tag, poolTagLabelPrefix), "=")
+			if found {
+				labels[key] = value
+				continue
+			}
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags, labels, taints
+}
+
+func validatePoolTaints(i interface{}, p cty.Path) diag.Diagnostics {
+	diags := diag.Diagnostics(nil)
+
+	for key, rawValue := range i.(map[string]interface{}) {
+		value := rawValue.(string)
+
+		_, effect, found := strings.Cut(value, ":")
+		if !found || !slices.Contains(poolTaintEffects, effect) {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "invalid taint " + key,
+				Detail:        fmt.Sprintf("expected a taint like value:Effect with an effect in %s, got %s", strings.Join(poolTaintEffects, ", "), value),
+				AttributePath: p.IndexString(key),
+			})
+		}
+	}
+
+	return diags
+}
+
+// customizeDiffK8SClusterAdmissionPlugins checks at plan time that the admission plugins are supported by the
+// version of the cluster, so that a security baseline such as PodSecurity is not rejected mid-apply.
+func customizeDiffK8SClusterAdmissionPlugins(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	plugins := types.ExpandStrings(diff.Get("admission_plugins"))
+	if len(plugins) == 0 || !diff.NewValueKnown("version") || !diff.NewValueKnown("admission_plugins") {
+		return nil
+	}
+	if !diff.HasChange("admission_plugins") && !diff.HasChange("version") {
+		return nil
+	}
+
+	region, err := meta.ExtractRegion(diff, m)
+	if err != nil {
+		return err
+	}
+
+	version, err := findK8SVersion(ctx, k8s.NewAPI(meta.ExtractScwClient(m)), region, diff.Get("version").(string))
+	if err != nil || version == nil {
+		// The version itself is checked by the API on apply.
+		return nil
+	}
+
+	unsupported := UnsupportedAdmissionPlugins(plugins, version.AvailableAdmissionPlugins)
+	if len(unsupported) > 0 {
+		return fmt.Errorf("admission plugins %s are not supported by version %s, available plugins are: %s",
+			strings.Join(unsupported, ", "), version.Name, strings.Join(version.AvailableAdmissionPlugins, ", "))
+	}
+
+	return nil
+}
+
+// findK8SVersion returns the given version, or the latest patch of a minor version x.y
+func findK8SVersion(ctx context.Context, k8sAPI *k8s.API, region scw.Region, name string) (*k8s.Version, error) {
+	res, err := k8sAPI.ListVersions(&k8s.ListVersionsRequest{
+		Region: region,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, version := range res.Versions {
+		if version.Name == name || strings.HasPrefix(version.Name, name+".") {
+			return version, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// UnsupportedAdmissionPlugins returns the plugins missing from the available ones
+func UnsupportedAdmissionPlugins(plugins []string, available []string) []string {
+	unsupported := []string(nil)
+	for _, plugin := range plugins {
+		if !slices.Contains(available, plugin) {
+			unsupported = append(unsupported, plugin)
+		}
+	}
+
+	return unsupported
+}
+
+// kubeconfigCertificateExpiresAt returns the expiration date of a base64 encoded PEM certificate from a kubeconfig
+func kubeconfigCertificateExpiresAt(certificateData string) (*time.Time, error) {
+	rawCertificate, err := base64.StdEncoding.DecodeString(certificateData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode certificate: %w", err)
+	}
+
+	block, _ := pem.Decode(rawCertificate)
+	if block == nil {
+		return nil, errors.New("certificate is not PEM encoded")
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate: %w", err)
+	}
+
+	return &certificate.NotAfter, nil
+}
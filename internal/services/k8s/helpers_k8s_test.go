@@ -0,0 +1,57 @@
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/k8s"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandPoolTags(t *testing.T) {
+	tags := k8s.ExpandPoolTags(
+		[]string{"foo"},
+		map[string]string{"team": "data", "env": "prod"},
+		map[string]string{"dedicated": "gpu:NoSchedule"},
+	)
+
+	assert.Equal(t, []string{
+		"foo",
+		"noprefix=env=prod",
+		"noprefix=team=data",
+		"taint=noprefix=dedicated=gpu:NoSchedule",
+	}, tags)
+}
+
+func TestFlattenPoolTags(t *testing.T) {
+	poolTags := []string{
+		"foo",
+		"noprefix=env=prod",
+		"noprefix=legacy=true",
+		"taint=noprefix=dedicated=gpu:NoSchedule",
+		"taint=scaleway=true:NoExecute",
+	}
+
+	tags, labels, taints := k8s.FlattenPoolTags(poolTags, []string{"foo", "noprefix=legacy=true"})
+
+	assert.Equal(t, []string{"foo", "noprefix=legacy=true", "taint=scaleway=true:NoExecute"}, tags)
+	assert.Equal(t, map[string]interface{}{"env": "prod"}, labels)
+	assert.Equal(t, map[string]interface{}{"dedicated": "gpu:NoSchedule"}, taints)
+}
+
+func TestIsMinorVersionDrift(t *testing.T) {
+	assert.True(t, k8s.IsMinorVersionDrift("1.31", "1.30"))
+	assert.True(t, k8s.IsMinorVersionDrift("1.31.2", "1.30"))
+	assert.True(t, k8s.IsMinorVersionDrift("2.0", "1.30"))
+	assert.False(t, k8s.IsMinorVersionDrift("1.30", "1.30"))
+	assert.False(t, k8s.IsMinorVersionDrift("1.30", "1.31"))
+	assert.False(t, k8s.IsMinorVersionDrift("", "1.30"))
+	assert.False(t, k8s.IsMinorVersionDrift("1.30", "latest"))
+}
+
+func TestUnsupportedAdmissionPlugins(t *testing.T) {
+	available := []string{"AlwaysPullImages", "PodNodeSelector", "PodTolerationRestriction"}
+
+	assert.Empty(t, k8s.UnsupportedAdmissionPlugins([]string{"AlwaysPullImages", "PodNodeSelector"}, available))
+	assert.Equal(t, []string{"PodSecurityPolicy"}, k8s.UnsupportedAdmissionPlugins([]string{"PodNodeSelector", "PodSecurityPolicy"}, available))
+	assert.Empty(t, k8s.UnsupportedAdmissionPlugins(nil, available))
+}
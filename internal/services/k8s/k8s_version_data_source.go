@@ -45,6 +45,14 @@ func DataSourceVersion() *schema.Resource {
 				},
 				Description: "The list of supported feature gates for this version",
 			},
+			"available_admission_plugins": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The list of supported admission plugins for this version",
+			},
 			"region": regional.Schema(),
 		},
 	}
@@ -91,6 +99,7 @@ func DataSourceK8SVersionRead(ctx context.Context, d *schema.ResourceData, m int
 	_ = d.Set("available_cnis", version.AvailableCnis)
 	_ = d.Set("available_container_runtimes", version.AvailableContainerRuntimes)
 	_ = d.Set("available_feature_gates", version.AvailableFeatureGates)
+	_ = d.Set("available_admission_plugins", version.AvailableAdmissionPlugins)
 	_ = d.Set("region", region)
 
 	return nil
@@ -90,6 +90,23 @@ func ResourcePool() *schema.Resource {
 				Optional:    true,
 				Description: "The tags associated with the pool",
 			},
+			"node_labels": {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "The labels applied to the nodes of the pool",
+			},
+			"node_taints": {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:         true,
+				ValidateDiagFunc: validatePoolTaints,
+				Description:      "The taints applied to the nodes of the pool, as value:Effect indexed by key",
+			},
 			"container_runtime": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -244,7 +261,7 @@ func ResourceK8SPoolCreate(ctx context.Context, d *schema.ResourceData, m interf
 		Autoscaling:      d.Get("autoscaling").(bool),
 		Autohealing:      d.Get("autohealing").(bool),
 		Size:             uint32(d.Get("size").(int)),
-		Tags:             types.ExpandStrings(d.Get("tags")),
+		Tags:             ExpandPoolTags(types.ExpandStrings(d.Get("tags")), types.ExpandMapStringString(d.Get("node_labels")), types.ExpandMapStringString(d.Get("node_taints"))),
 		Zone:             scw.Zone(d.Get("zone").(string)),
 		KubeletArgs:      expandKubeletArgs(d.Get("kubelet_args")),
 		PublicIPDisabled: d.Get("public_ip_disabled").(bool),
@@ -379,7 +396,10 @@ func ResourceK8SPoolRead(ctx context.Context, d *schema.ResourceData, m interfac
 	if pool.RootVolumeSize != nil {
 		_ = d.Set("root_volume_size_in_gb", int(*pool.RootVolumeSize)/1e9)
 	}
-	_ = d.Set("tags", pool.Tags)
+	tags, nodeLabels, nodeTaints := FlattenPoolTags(pool.Tags, types.ExpandStrings(d.Get("tags")))
+	_ = d.Set("tags", tags)
+	_ = d.Set("node_labels", nodeLabels)
+	_ = d.Set("node_taints", nodeTaints)
 	_ = d.Set("container_runtime", pool.ContainerRuntime)
 	_ = d.Set("created_at", pool.CreatedAt.Format(time.RFC3339))
 	_ = d.Set("updated_at", pool.UpdatedAt.Format(time.RFC3339))
@@ -432,8 +452,9 @@ func ResourceK8SPoolUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		updateRequest.Size = scw.Uint32Ptr(uint32(d.Get("size").(int)))
 	}
 
-	if d.HasChange("tags") {
-		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if d.HasChanges("tags", "node_labels", "node_taints") {
+		tags := ExpandPoolTags(types.ExpandStrings(d.Get("tags")), types.ExpandMapStringString(d.Get("node_labels")), types.ExpandMapStringString(d.Get("node_taints")))
+		updateRequest.Tags = &tags
 	}
 
 	if d.HasChange("kubelet_args") {
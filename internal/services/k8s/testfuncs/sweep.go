@@ -28,6 +28,9 @@ func testSweepK8SCluster(_ string) error {
 		}
 
 		for _, cluster := range listClusters.Clusters {
+			if !acctest.ShouldSweep("scaleway_k8s_cluster", cluster.ID, cluster.Name, cluster.Tags) {
+				continue
+			}
 			// remove pools
 			listPools, err := k8sAPI.ListPools(&k8sSDK.ListPoolsRequest{
 				Region:    region,
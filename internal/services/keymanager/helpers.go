@@ -0,0 +1,138 @@
+package keymanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	keymanagerSDK "github.com/scaleway/scaleway-sdk-go/api/key_manager/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+)
+
+const keyUsageSymmetricEncryption = "symmetric_encryption"
+
+// newAPIWithRegion returns a new Key Manager API and the region for a Create request
+func newAPIWithRegion(d *schema.ResourceData, m interface{}) (*keymanagerSDK.API, scw.Region, error) {
+	api := keymanagerSDK.NewAPI(meta.ExtractScwClient(m))
+
+	region, err := meta.ExtractRegion(d, m)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return api, region, nil
+}
+
+// NewAPIWithRegionAndID returns a Key Manager API with region and ID extracted from the state
+func NewAPIWithRegionAndID(m interface{}, id string) (*keymanagerSDK.API, scw.Region, string, error) {
+	api := keymanagerSDK.NewAPI(meta.ExtractScwClient(m))
+
+	region, id, err := regional.ParseID(id)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return api, region, id, nil
+}
+
+func expandKeyUsage(usage string, algorithm string) *keymanagerSDK.KeyUsage {
+	switch usage {
+	case keyUsageSymmetricEncryption:
+		return &keymanagerSDK.KeyUsage{
+			SymmetricEncryption: (*keymanagerSDK.KeyAlgorithmSymmetricEncryption)(&algorithm),
+		}
+	default:
+		return nil
+	}
+}
+
+// flattenKeyUsage returns the usage and the algorithm of a key.
+func flattenKeyUsage(usage *keymanagerSDK.KeyUsage) (string, string) {
+	if usage != nil && usage.SymmetricEncryption != nil {
+		return keyUsageSymmetricEncryption, usage.SymmetricEncryption.String()
+	}
+
+	return "", ""
+}
+
+func expandKeyRotationPolicy(raw interface{}) (*keymanagerSDK.KeyRotationPolicy, error) {
+	rawList := raw.([]interface{})
+	if len(rawList) == 0 || rawList[0] == nil {
+		return nil, nil
+	}
+	rawPolicy := rawList[0].(map[string]interface{})
+
+	rotationPeriod, err := types.ExpandDuration(rawPolicy["rotation_period"])
+	if err != nil {
+		return nil, fmt.Errorf("error parsing rotation_period: %w", err)
+	}
+
+	policy := &keymanagerSDK.KeyRotationPolicy{}
+	if rotationPeriod != nil {
+		policy.RotationPeriod = scw.NewDurationFromTimeDuration(*rotationPeriod)
+	}
+
+	return policy, nil
+}
+
+func flattenKeyRotationPolicy(policy *keymanagerSDK.KeyRotationPolicy) []map[string]interface{} {
+	if policy == nil || policy.RotationPeriod == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{{
+		"rotation_period":  types.FlattenDuration(policy.RotationPeriod.ToTimeDuration()),
+		"next_rotation_at": types.FlattenTime(policy.NextRotationAt),
+	}}
+}
+
+// updateKeyProtection sets the protected value of a key to requested one.
+func updateKeyProtection(ctx context.Context, api *keymanagerSDK.API, region scw.Region, keyID string, protected bool) error {
+	var err error
+	if protected {
+		_, err = api.ProtectKey(&keymanagerSDK.ProtectKeyRequest{
+			Region: region,
+			KeyID:  keyID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to protect key %s: %w", keyID, err)
+		}
+	} else {
+		_, err = api.UnprotectKey(&keymanagerSDK.UnprotectKeyRequest{
+			Region: region,
+			KeyID:  keyID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to unprotect key %s: %w", keyID, err)
+		}
+	}
+
+	return nil
+}
+
+// updateKeyState enables or disables a key.
+func updateKeyState(ctx context.Context, api *keymanagerSDK.API, region scw.Region, keyID string, enabled bool) error {
+	var err error
+	if enabled {
+		_, err = api.EnableKey(&keymanagerSDK.EnableKeyRequest{
+			Region: region,
+			KeyID:  keyID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to enable key %s: %w", keyID, err)
+		}
+	} else {
+		_, err = api.DisableKey(&keymanagerSDK.DisableKeyRequest{
+			Region: region,
+			KeyID:  keyID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to disable key %s: %w", keyID, err)
+		}
+	}
+
+	return nil
+}
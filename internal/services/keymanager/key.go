@@ -0,0 +1,299 @@
+package keymanager
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	keymanagerSDK "github.com/scaleway/scaleway-sdk-go/api/key_manager/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func ResourceKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceKeyCreate,
+		ReadContext:   ResourceKeyRead,
+		UpdateContext: ResourceKeyUpdate,
+		DeleteContext: ResourceKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the key",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the key",
+			},
+			"tags": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "List of tags [\"tag1\", \"tag2\", ...] associated to the key",
+			},
+			"usage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      keyUsageSymmetricEncryption,
+				ValidateFunc: validation.StringInSlice([]string{keyUsageSymmetricEncryption}, false),
+				Description:  "The usage of the key, only symmetric_encryption is supported",
+			},
+			"algorithm": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          keymanagerSDK.KeyAlgorithmSymmetricEncryptionAes256Gcm.String(),
+				ValidateDiagFunc: verify.ValidateEnum[keymanagerSDK.KeyAlgorithmSymmetricEncryption](),
+				Description:      "The algorithm of the key",
+			},
+			"rotation_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The policy rotating the key automatically",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rotation_period": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: dsf.Duration,
+							ValidateDiagFunc: verify.IsDuration(),
+							Description:      "Time interval between two key rotations, in Go duration format, e.g. 720h",
+						},
+						"next_rotation_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date and time of the next key rotation",
+						},
+					},
+				},
+			},
+			"protected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the key is protected against deletion",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the key can be used for cryptographic operations",
+			},
+			// Computed
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the key",
+			},
+			"origin": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The origin of the key material",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the key is locked, a locked key cannot be modified or deleted",
+			},
+			"rotation_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of times the key has been rotated",
+			},
+			"rotated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time of the last key rotation",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time of the key creation",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time of the last key update",
+			},
+			"region":     regional.Schema(),
+			"project_id": account.ProjectIDSchema(),
+		},
+	}
+}
+
+func ResourceKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, err := newAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rotationPolicy, err := expandKeyRotationPolicy(d.Get("rotation_policy"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	key, err := api.CreateKey(&keymanagerSDK.CreateKeyRequest{
+		Region:         region,
+		ProjectID:      d.Get("project_id").(string),
+		Name:           types.ExpandStringPtr(types.ExpandOrGenerateString(d.Get("name"), "key")),
+		Description:    types.ExpandStringPtr(d.Get("description")),
+		Tags:           types.ExpandStrings(d.Get("tags")),
+		Usage:          expandKeyUsage(d.Get("usage").(string), d.Get("algorithm").(string)),
+		RotationPolicy: rotationPolicy,
+		Unprotected:    !d.Get("protected").(bool),
+		Origin:         keymanagerSDK.KeyOriginScalewayKms,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(regional.NewIDString(region, key.ID))
+
+	if !d.Get("enabled").(bool) {
+		err = updateKeyState(ctx, api, region, key.ID, false)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ResourceKeyRead(ctx, d, m)
+}
+
+func ResourceKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	key, err := api.GetKey(&keymanagerSDK.GetKeyRequest{
+		Region: region,
+		KeyID:  id,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if httperrors.Is404(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	usage, algorithm := flattenKeyUsage(key.Usage)
+
+	_ = d.Set("name", key.Name)
+	_ = d.Set("description", types.FlattenStringPtr(key.Description))
+	_ = d.Set("tags", types.FlattenSliceString(key.Tags))
+	_ = d.Set("usage", usage)
+	_ = d.Set("algorithm", algorithm)
+	_ = d.Set("rotation_policy", flattenKeyRotationPolicy(key.RotationPolicy))
+	_ = d.Set("protected", key.Protected)
+	_ = d.Set("enabled", key.State == keymanagerSDK.KeyStateEnabled)
+	_ = d.Set("state", key.State.String())
+	_ = d.Set("origin", key.Origin.String())
+	_ = d.Set("locked", key.Locked)
+	_ = d.Set("rotation_count", int(key.RotationCount))
+	_ = d.Set("rotated_at", types.FlattenTime(key.RotatedAt))
+	_ = d.Set("created_at", types.FlattenTime(key.CreatedAt))
+	_ = d.Set("updated_at", types.FlattenTime(key.UpdatedAt))
+	_ = d.Set("region", string(region))
+	_ = d.Set("project_id", key.ProjectID)
+
+	return nil
+}
+
+func ResourceKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updateRequest := &keymanagerSDK.UpdateKeyRequest{
+		Region: region,
+		KeyID:  id,
+	}
+
+	hasChanged := false
+
+	if d.HasChange("name") {
+		updateRequest.Name = types.ExpandUpdatedStringPtr(d.Get("name"))
+		hasChanged = true
+	}
+
+	if d.HasChange("description") {
+		updateRequest.Description = types.ExpandUpdatedStringPtr(d.Get("description"))
+		hasChanged = true
+	}
+
+	if d.HasChange("tags") {
+		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+		hasChanged = true
+	}
+
+	if d.HasChange("rotation_policy") {
+		updateRequest.RotationPolicy, err = expandKeyRotationPolicy(d.Get("rotation_policy"))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		// Without a policy the current one would be kept, an empty policy disables the automatic rotation
+		if updateRequest.RotationPolicy == nil {
+			updateRequest.RotationPolicy = &keymanagerSDK.KeyRotationPolicy{}
+		}
+		hasChanged = true
+	}
+
+	if hasChanged {
+		_, err = api.UpdateKey(updateRequest, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("protected") {
+		err = updateKeyProtection(ctx, api, region, id, d.Get("protected").(bool))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		err = updateKeyState(ctx, api, region, id, d.Get("enabled").(bool))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ResourceKeyRead(ctx, d, m)
+}
+
+func ResourceKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = api.DeleteKey(&keymanagerSDK.DeleteKeyRequest{
+		Region: region,
+		KeyID:  id,
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package keymanager
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	keymanagerSDK "github.com/scaleway/scaleway-sdk-go/api/key_manager/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func DataSourceKey() *schema.Resource {
+	// Generate datasource schema from resource
+	dsSchema := datasource.SchemaFromResourceSchema(ResourceKey().Schema)
+
+	datasource.AddOptionalFieldsToSchema(dsSchema, "name", "region", "project_id")
+
+	dsSchema["name"].ConflictsWith = []string{"key_id"}
+	dsSchema["key_id"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "The ID of the key",
+		ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+		ConflictsWith:    []string{"name"},
+	}
+
+	return &schema.Resource{
+		ReadContext: DataSourceKeyRead,
+		Schema:      dsSchema,
+	}
+}
+
+func DataSourceKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, err := newAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keyID, ok := d.GetOk("key_id")
+	if !ok {
+		keyName := d.Get("name").(string)
+		res, err := api.ListKeys(&keymanagerSDK.ListKeysRequest{
+			Region:    region,
+			Name:      types.ExpandStringPtr(keyName),
+			ProjectID: types.ExpandStringPtr(d.Get("project_id")),
+		}, scw.WithAllPages(), scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		foundKey, err := datasource.FindExact(
+			res.Keys,
+			func(s *keymanagerSDK.Key) bool { return s.Name == keyName },
+			keyName,
+		)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		keyID = foundKey.ID
+	}
+
+	regionalID := datasource.NewRegionalID(keyID, region)
+	d.SetId(regionalID)
+	_ = d.Set("key_id", regionalID)
+
+	diags := ResourceKeyRead(ctx, d, m)
+	if diags != nil {
+		return append(diags, diag.Errorf("failed to read key")...)
+	}
+
+	if d.Id() == "" {
+		return diag.Errorf("key (%s) not found", regionalID)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package keymanager_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceKey_Basic(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      isKeyDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_key_manager_key" "main" {
+						name = "tf-tests-ds-key-manager-key-basic"
+					}
+
+					data "scaleway_key_manager_key" "by_name" {
+						name = scaleway_key_manager_key.main.name
+					}
+
+					data "scaleway_key_manager_key" "by_id" {
+						key_id = scaleway_key_manager_key.main.id
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.scaleway_key_manager_key.by_name", "key_id", "scaleway_key_manager_key.main", "id"),
+					resource.TestCheckResourceAttr("data.scaleway_key_manager_key.by_name", "enabled", "true"),
+					resource.TestCheckResourceAttrPair("data.scaleway_key_manager_key.by_id", "name", "scaleway_key_manager_key.main", "name"),
+				),
+			},
+			{
+				Config: `
+					data "scaleway_key_manager_key" "unknown" {
+						name = "tf-tests-ds-key-manager-key-unknown"
+					}`,
+				ExpectError: regexp.MustCompile("no element found with the name tf-tests-ds-key-manager-key-unknown"),
+			},
+		},
+	})
+}
@@ -0,0 +1,133 @@
+package keymanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	keymanagerSDK "github.com/scaleway/scaleway-sdk-go/api/key_manager/v1alpha1"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/keymanager"
+)
+
+func TestAccKey_Basic(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      isKeyDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_key_manager_key" "main" {
+						name      = "tf-tests-key-manager-key-basic"
+						protected = true
+						enabled   = false
+						rotation_policy {
+							rotation_period = "720h"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					isKeyPresent(tt, "scaleway_key_manager_key.main"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "name", "tf-tests-key-manager-key-basic"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "rotation_policy.0.rotation_period", "720h0m0s"),
+					resource.TestCheckResourceAttrSet("scaleway_key_manager_key.main", "rotation_policy.0.next_rotation_at"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "protected", "true"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "enabled", "false"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "state", keymanagerSDK.KeyStateDisabled.String()),
+				),
+			},
+			{
+				// Removing the policy disables the rotation explicitly
+				Config: `
+					resource "scaleway_key_manager_key" "main" {
+						name      = "tf-tests-key-manager-key-basic"
+						protected = false
+						enabled   = true
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					isKeyRotationDisabled(tt, "scaleway_key_manager_key.main"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "rotation_policy.#", "0"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "protected", "false"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "enabled", "true"),
+					resource.TestCheckResourceAttr("scaleway_key_manager_key.main", "state", keymanagerSDK.KeyStateEnabled.String()),
+				),
+			},
+			{
+				ResourceName:      "scaleway_key_manager_key.main",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func getKey(tt *acctest.TestTools, state *terraform.State, n string) (*keymanagerSDK.Key, error) {
+	rs, ok := state.RootModule().Resources[n]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", n)
+	}
+
+	return getKeyByID(tt, rs.Primary.ID)
+}
+
+func getKeyByID(tt *acctest.TestTools, keyID string) (*keymanagerSDK.Key, error) {
+	api, region, id, err := keymanager.NewAPIWithRegionAndID(tt.Meta, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetKey(&keymanagerSDK.GetKeyRequest{
+		Region: region,
+		KeyID:  id,
+	})
+}
+
+func isKeyPresent(tt *acctest.TestTools, n string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getKey(tt, state, n)
+
+		return err
+	}
+}
+
+func isKeyRotationDisabled(tt *acctest.TestTools, n string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		key, err := getKey(tt, state, n)
+		if err != nil {
+			return err
+		}
+
+		if key.RotationPolicy != nil && key.RotationPolicy.RotationPeriod != nil {
+			return fmt.Errorf("key %s still rotates every %s", key.ID, key.RotationPolicy.RotationPeriod.ToTimeDuration())
+		}
+
+		return nil
+	}
+}
+
+func isKeyDestroyed(tt *acctest.TestTools) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for _, rs := range state.RootModule().Resources {
+			if rs.Type != "scaleway_key_manager_key" {
+				continue
+			}
+
+			_, err := getKeyByID(tt, rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("key (%s) still exists", rs.Primary.ID)
+			}
+			if !httperrors.Is404(err) {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
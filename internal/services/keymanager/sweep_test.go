@@ -0,0 +1,16 @@
+package keymanager_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	keymanagertestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/keymanager/testfuncs"
+)
+
+func init() {
+	keymanagertestfuncs.AddTestSweepers()
+}
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
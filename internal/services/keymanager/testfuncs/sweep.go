@@ -0,0 +1,62 @@
+package keymanagertestfuncs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	keymanagerSDK "github.com/scaleway/scaleway-sdk-go/api/key_manager/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/logging"
+)
+
+func AddTestSweepers() {
+	resource.AddTestSweepers("scaleway_key_manager_key", &resource.Sweeper{
+		Name: "scaleway_key_manager_key",
+		F:    testSweepKey,
+	})
+}
+
+func testSweepKey(_ string) error {
+	return acctest.SweepRegions(scw.AllRegions, func(scwClient *scw.Client, region scw.Region) error {
+		keyManagerAPI := keymanagerSDK.NewAPI(scwClient)
+
+		logging.L.Debugf("sweeper: deleting the keys in (%s)", region)
+
+		listKeys, err := keyManagerAPI.ListKeys(&keymanagerSDK.ListKeysRequest{Region: region}, scw.WithAllPages())
+		if err != nil {
+			return fmt.Errorf("error listing keys in (%s) in sweeper: %s", region, err)
+		}
+
+		for _, key := range listKeys.Keys {
+			// Locked keys cannot be deleted
+			if key.Locked || !acctest.ShouldSweep("scaleway_key_manager_key", key.ID, key.Name, key.Tags) {
+				continue
+			}
+
+			if key.Protected {
+				_, err := keyManagerAPI.UnprotectKey(&keymanagerSDK.UnprotectKeyRequest{
+					KeyID:  key.ID,
+					Region: region,
+				})
+				if err != nil {
+					logging.L.Debugf("sweeper: error (%s)", err)
+
+					return fmt.Errorf("error unprotecting key in sweeper: %s", err)
+				}
+			}
+
+			err := keyManagerAPI.DeleteKey(&keymanagerSDK.DeleteKeyRequest{
+				KeyID:  key.ID,
+				Region: region,
+			})
+			if err != nil {
+				logging.L.Debugf("sweeper: error (%s)", err)
+
+				return fmt.Errorf("error deleting key in sweeper: %s", err)
+			}
+		}
+
+		return nil
+	})
+}
@@ -32,7 +32,7 @@ func ResourceACL() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithZone(),
 				Description:      "The frontend ID on which the ACL is applied",
 			},
 			"name": {
@@ -26,7 +26,7 @@ func ResourceBackend() *schema.Resource {
 		UpdateContext: resourceLbBackendUpdate,
 		DeleteContext: resourceLbBackendDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateBackendByName,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(defaultLbLbTimeout),
@@ -317,6 +317,7 @@ E.g. 'failover-website.s3-website.fr-par.scw.cloud' if your bucket website URL i
 				Description:  "Number of retries when a backend server connection failed",
 			},
 		},
+		CustomizeDiff: customizeDiffLBBackendStickySessions,
 	}
 }
 
@@ -26,7 +26,7 @@ func ResourceFrontend() *schema.Resource {
 		UpdateContext: resourceLbFrontendUpdate,
 		DeleteContext: resourceLbFrontendDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateFrontendByName,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(defaultLbLbTimeout),
@@ -43,14 +43,14 @@ func ResourceFrontend() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithZone(),
 				Description:      "The load-balancer ID",
 			},
 			"backend_id": {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithZone(),
 				Description:      "The load-balancer backend ID",
 			},
 			"name": {
@@ -83,7 +83,7 @@ func ResourceFrontend() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
-					ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+					ValidateDiagFunc: verify.IsUUIDorUUIDWithZone(),
 				},
 				Description:      "Collection of Certificate IDs related to the load balancer and domain",
 				DiffSuppressFunc: dsf.OrderDiff,
@@ -218,6 +218,11 @@ func ResourceFrontend() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"ssl_compatibility_level": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The minimal SSL/TLS version enforced by the load-balancer on this frontend",
+			},
 		},
 	}
 }
@@ -315,6 +320,9 @@ func resourceLbFrontendRead(ctx context.Context, d *schema.ResourceData, m inter
 	_ = d.Set("inbound_port", int(frontend.InboundPort))
 	_ = d.Set("timeout_client", types.FlattenDuration(frontend.TimeoutClient))
 	_ = d.Set("enable_http3", frontend.EnableHTTP3)
+	if frontend.LB != nil {
+		_ = d.Set("ssl_compatibility_level", frontend.LB.SslCompatibilityLevel.String())
+	}
 
 	if frontend.Certificate != nil { //nolint:staticcheck
 		_ = d.Set("certificate_id", zonal.NewIDString(zone, frontend.Certificate.ID)) //nolint:staticcheck
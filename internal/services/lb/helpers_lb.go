@@ -15,6 +15,7 @@ import (
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	validator "github.com/scaleway/scaleway-sdk-go/validation"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
@@ -211,6 +212,29 @@ func customizeDiffLBIPIDs(_ context.Context, diff *schema.ResourceDiff, _ interf
 	return nil
 }
 
+// customizeDiffLBBackendStickySessions checks the sticky sessions configuration, which the API only rejects once the backend is created.
+func customizeDiffLBBackendStickySessions(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("sticky_sessions") || !diff.NewValueKnown("sticky_sessions_cookie_name") {
+		return nil
+	}
+
+	return ValidateStickySessions(diff.Get("sticky_sessions").(string), diff.Get("sticky_sessions_cookie_name").(string))
+}
+
+// ValidateStickySessions checks that a cookie name is set with cookie sticky sessions, and only with them.
+func ValidateStickySessions(stickySessions string, cookieName string) error {
+	isCookie := lbSDK.StickySessionsType(stickySessions) == lbSDK.StickySessionsTypeCookie
+
+	switch {
+	case isCookie && cookieName == "":
+		return errors.New("sticky_sessions_cookie_name must be set when sticky_sessions is cookie")
+	case !isCookie && cookieName != "":
+		return fmt.Errorf("sticky_sessions_cookie_name can only be set when sticky_sessions is cookie, not %s", stickySessions)
+	}
+
+	return nil
+}
+
 func customizeDiffAssignFlexibleIPv6(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
 	oldValue, newValue := diff.GetChange("assign_flexible_ipv6")
 	if oldValue.(bool) && !newValue.(bool) {
@@ -218,3 +242,130 @@ func customizeDiffAssignFlexibleIPv6(_ context.Context, diff *schema.ResourceDif
 	}
 	return nil
 }
+
+// ParseImportIDByName splits an import ID of the form {zone}/{lb-name}[/{name}] into the zone and the names to resolve.
+// It returns false for the regular {zone}/{id} import IDs.
+func ParseImportIDByName(id string) (scw.Zone, []string, bool) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) < 2 || (len(parts) == 2 && validator.IsUUID(parts[1])) {
+		return "", nil, false
+	}
+
+	return scw.Zone(parts[0]), parts[1:], true
+}
+
+func findLBByName(ctx context.Context, api *lbSDK.ZonedAPI, zone scw.Zone, name string) (*lbSDK.LB, error) {
+	res, err := api.ListLBs(&lbSDK.ZonedAPIListLBsRequest{
+		Zone: zone,
+		Name: types.ExpandStringPtr(name),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return datasource.FindExact(
+		res.LBs,
+		func(s *lbSDK.LB) bool { return s.Name == name },
+		name,
+	)
+}
+
+// importStateLBByName imports a load balancer from {zone}/{id} or {zone}/{lb-name}.
+func importStateLBByName(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	zone, names, isNamed := ParseImportIDByName(d.Id())
+	if !isNamed {
+		return schema.ImportStatePassthroughContext(ctx, d, m)
+	}
+	if len(names) != 1 {
+		return nil, fmt.Errorf("invalid import ID %q, expected {zone}/{id} or {zone}/{lb-name}", d.Id())
+	}
+
+	lb, err := findLBByName(ctx, lbSDK.NewZonedAPI(meta.ExtractScwClient(m)), zone, names[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to find load balancer %s: %w", names[0], err)
+	}
+
+	d.SetId(zonal.NewIDString(zone, lb.ID))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// importStateFrontendByName imports a frontend from {zone}/{id} or {zone}/{lb-name}/{frontend-name}.
+func importStateFrontendByName(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	zone, names, isNamed := ParseImportIDByName(d.Id())
+	if !isNamed {
+		return schema.ImportStatePassthroughContext(ctx, d, m)
+	}
+	if len(names) != 2 {
+		return nil, fmt.Errorf("invalid import ID %q, expected {zone}/{id} or {zone}/{lb-name}/{frontend-name}", d.Id())
+	}
+
+	api := lbSDK.NewZonedAPI(meta.ExtractScwClient(m))
+
+	lb, err := findLBByName(ctx, api, zone, names[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to find load balancer %s: %w", names[0], err)
+	}
+
+	res, err := api.ListFrontends(&lbSDK.ZonedAPIListFrontendsRequest{
+		Zone: zone,
+		LBID: lb.ID,
+		Name: types.ExpandStringPtr(names[1]),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	frontend, err := datasource.FindExact(
+		res.Frontends,
+		func(s *lbSDK.Frontend) bool { return s.Name == names[1] },
+		names[1],
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find frontend %s of load balancer %s: %w", names[1], names[0], err)
+	}
+
+	d.SetId(zonal.NewIDString(zone, frontend.ID))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// importStateBackendByName imports a backend from {zone}/{id} or {zone}/{lb-name}/{backend-name}.
+func importStateBackendByName(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	zone, names, isNamed := ParseImportIDByName(d.Id())
+	if !isNamed {
+		return schema.ImportStatePassthroughContext(ctx, d, m)
+	}
+	if len(names) != 2 {
+		return nil, fmt.Errorf("invalid import ID %q, expected {zone}/{id} or {zone}/{lb-name}/{backend-name}", d.Id())
+	}
+
+	api := lbSDK.NewZonedAPI(meta.ExtractScwClient(m))
+
+	lb, err := findLBByName(ctx, api, zone, names[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to find load balancer %s: %w", names[0], err)
+	}
+
+	res, err := api.ListBackends(&lbSDK.ZonedAPIListBackendsRequest{
+		Zone: zone,
+		LBID: lb.ID,
+		Name: types.ExpandStringPtr(names[1]),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	backend, err := datasource.FindExact(
+		res.Backends,
+		func(s *lbSDK.Backend) bool { return s.Name == names[1] },
+		names[1],
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find backend %s of load balancer %s: %w", names[1], names[0], err)
+	}
+
+	d.SetId(zonal.NewIDString(zone, backend.ID))
+
+	return []*schema.ResourceData{d}, nil
+}
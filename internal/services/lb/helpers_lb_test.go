@@ -159,3 +159,54 @@ func TestPrivateNetworksCompare(t *testing.T) {
 		})
 	}
 }
+
+func TestParseImportIDByName(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		expectedZone  scw.Zone
+		expectedNames []string
+		isNamed       bool
+	}{
+		{
+			name:    "zonal ID",
+			id:      "fr-par-1/6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			isNamed: false,
+		},
+		{
+			name:    "ID without zone",
+			id:      "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			isNamed: false,
+		},
+		{
+			name:          "load balancer name",
+			id:            "fr-par-1/production",
+			expectedZone:  scw.ZoneFrPar1,
+			expectedNames: []string{"production"},
+			isNamed:       true,
+		},
+		{
+			name:          "frontend name",
+			id:            "nl-ams-1/production/https",
+			expectedZone:  scw.ZoneNlAms1,
+			expectedNames: []string{"production", "https"},
+			isNamed:       true,
+		},
+		{
+			name:          "name containing a slash",
+			id:            "fr-par-1/production/api/v2",
+			expectedZone:  scw.ZoneFrPar1,
+			expectedNames: []string{"production", "api/v2"},
+			isNamed:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zone, names, isNamed := lb.ParseImportIDByName(tt.id)
+			assert.Equal(t, tt.isNamed, isNamed)
+			assert.Equal(t, tt.expectedZone, zone)
+			assert.Equal(t, tt.expectedNames, names)
+		})
+	}
+}
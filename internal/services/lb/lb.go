@@ -14,6 +14,7 @@ import (
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/console"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
@@ -35,7 +36,7 @@ func ResourceLb() *schema.Resource {
 		UpdateContext: resourceLbUpdate,
 		DeleteContext: resourceLbDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateLBByName,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(defaultLbLbTimeout),
@@ -85,7 +86,7 @@ func ResourceLb() *schema.Resource {
 				Computed:         true,
 				Description:      "The load-balance public IP ID",
 				DiffSuppressFunc: dsf.Locality,
-				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithZone(),
 				Deprecated:       "Please use ip_ids",
 			},
 			"ip_address": {
@@ -101,8 +102,14 @@ func ResourceLb() *schema.Resource {
 			"release_ip": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Release the IPs related to this load-balancer",
-				Deprecated:  "The resource ip will be destroyed by it's own resource. Please set this to `false`",
+				Default:     false,
+				Description: "Release the IPs related to this load-balancer when it is deleted",
+			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Prevent the load-balancer from being deleted",
 			},
 			"private_network": {
 				Type:        schema.TypeSet,
@@ -123,7 +130,7 @@ func ResourceLb() *schema.Resource {
 						"private_network_id": {
 							Type:             schema.TypeString,
 							Required:         true,
-							ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+							ValidateDiagFunc: verify.IsUUIDorUUIDWithRegion(),
 							Description:      "The Private Network ID",
 						},
 						"static_config": {
@@ -193,7 +200,7 @@ func ResourceLb() *schema.Resource {
 				Computed: true,
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
-					ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+					ValidateDiagFunc: verify.IsUUIDorUUIDWithZone(),
 				},
 				Description:      "List of IP IDs to attach to the Load Balancer",
 				DiffSuppressFunc: dsf.OrderDiff,
@@ -202,6 +209,7 @@ func ResourceLb() *schema.Resource {
 			"region":          regional.ComputedSchema(),
 			"zone":            zonal.Schema(),
 			"organization_id": account.OrganizationIDSchema(),
+			"console_url":     console.URLSchema(),
 			"project_id":      account.ProjectIDSchema(),
 		},
 	}
@@ -287,11 +295,12 @@ func resourceLbRead(ctx context.Context, d *schema.ResourceData, m interface{})
 		return diag.FromErr(err)
 	}
 
-	_ = d.Set("release_ip", false)
+	_ = d.Set("release_ip", d.Get("release_ip").(bool))
 	_ = d.Set("name", lb.Name)
 	_ = d.Set("description", lb.Description)
 	_ = d.Set("zone", lb.Zone.String())
 	_ = d.Set("region", region.String())
+	_ = d.Set("console_url", console.URL("load-balancer/lbs", lb.Zone.String(), lb.ID))
 	_ = d.Set("organization_id", lb.OrganizationID)
 	_ = d.Set("project_id", lb.ProjectID)
 	_ = d.Set("tags", lb.Tags)
@@ -546,6 +555,10 @@ func resourceLbDelete(ctx context.Context, d *schema.ResourceData, m interface{}
 		return diag.FromErr(err)
 	}
 
+	if d.Get("deletion_protection").(bool) {
+		return diag.Errorf("load-balancer %s cannot be deleted while deletion_protection is enabled", d.Id())
+	}
+
 	// check if current lb is on stable state
 	currentLB, err := waitForInstances(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -582,7 +595,7 @@ func resourceLbDelete(ctx context.Context, d *schema.ResourceData, m interface{}
 	err = lbAPI.DeleteLB(&lbSDK.ZonedAPIDeleteLBRequest{
 		Zone:      zone,
 		LBID:      ID,
-		ReleaseIP: false,
+		ReleaseIP: d.Get("release_ip").(bool),
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
 		return diag.FromErr(err)
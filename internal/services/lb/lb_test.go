@@ -13,6 +13,7 @@ import (
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	instancechecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/testfuncs"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/lb"
 	lbchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/lb/testfuncs"
@@ -626,6 +627,106 @@ func TestAccLB_DifferentLocalityIPID(t *testing.T) {
 	})
 }
 
+func TestAccLB_DeletionProtection(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			isLbDestroyed(tt),
+			lbchecks.IsIPDestroyed(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_lb_ip main {
+					}
+
+					resource scaleway_lb main {
+						ip_id = scaleway_lb_ip.main.id
+						name = "test-lb-deletion-protection"
+						type = "LB-S"
+						deletion_protection = true
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					isLbPresent(tt, "scaleway_lb.main"),
+					resource.TestCheckResourceAttr("scaleway_lb.main", "deletion_protection", "true"),
+				),
+			},
+			{
+				Destroy: true,
+				Config: `
+					resource scaleway_lb_ip main {
+					}
+
+					resource scaleway_lb main {
+						ip_id = scaleway_lb_ip.main.id
+						name = "test-lb-deletion-protection"
+						type = "LB-S"
+						deletion_protection = true
+					}
+				`,
+				ExpectError: regexp.MustCompile("cannot be deleted while deletion_protection is enabled"),
+			},
+			{
+				Config: `
+					resource scaleway_lb_ip main {
+					}
+
+					resource scaleway_lb main {
+						ip_id = scaleway_lb_ip.main.id
+						name = "test-lb-deletion-protection"
+						type = "LB-S"
+						deletion_protection = false
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					isLbPresent(tt, "scaleway_lb.main"),
+					resource.TestCheckResourceAttr("scaleway_lb.main", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLB_ReleaseIP(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			isLbDestroyed(tt),
+			isLbIPReleased(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_lb main {
+						name = "test-lb-release-ip"
+						type = "LB-S"
+						assign_flexible_ip = true
+						release_ip = true
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					isLbPresent(tt, "scaleway_lb.main"),
+					resource.TestCheckResourceAttr("scaleway_lb.main", "release_ip", "true"),
+					acctest.CheckResourceAttrIPv4("scaleway_lb.main", "ip_address"),
+				),
+			},
+		},
+	})
+}
+
 func isLbPresent(tt *acctest.TestTools, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -699,3 +800,41 @@ func TestLbUpgradeV1SchemaUpgradeFunc(t *testing.T) {
 		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", v1Schema, actual)
 	}
 }
+
+// isLbIPReleased checks that the IPs of the destroyed load balancers with release_ip have been released
+func isLbIPReleased(tt *acctest.TestTools) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for _, rs := range state.RootModule().Resources {
+			if rs.Type != "scaleway_lb" || rs.Primary.Attributes["release_ip"] != "true" {
+				continue
+			}
+
+			lbAPI, zone, _, err := lb.NewAPIWithZoneAndID(tt.Meta, rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, ipID, err := zonal.ParseID(rs.Primary.Attributes["ip_id"])
+			if err != nil {
+				return err
+			}
+
+			_, err = lbAPI.GetIP(&lbSDK.ZonedAPIGetIPRequest{
+				Zone: zone,
+				IPID: ipID,
+			})
+
+			// If no error resource still exist
+			if err == nil {
+				return fmt.Errorf("IP (%s) of load balancer (%s) has not been released", ipID, rs.Primary.ID)
+			}
+
+			// Unexpected api error we return it
+			if !httperrors.Is404(err) {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,84 @@
+package lb
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+)
+
+func DataSourceOffers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceLbOffersRead,
+		Schema: map[string]*schema.Schema{
+			"only_available": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only list the offers that are in stock",
+			},
+			"offers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Computed:    true,
+							Type:        schema.TypeString,
+							Description: "The commercial type of the offer, to use as Load Balancer type",
+						},
+						"description": {
+							Computed:    true,
+							Type:        schema.TypeString,
+							Description: "The description of the offer",
+						},
+						"stock_status": {
+							Computed:    true,
+							Type:        schema.TypeString,
+							Description: "The stock status of the offer",
+						},
+						"zone": zonal.ComputedSchema(),
+					},
+				},
+			},
+			"zone": zonal.Schema(),
+		},
+	}
+}
+
+func DataSourceLbOffersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	lbAPI, zone, err := lbAPIWithZone(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := lbAPI.ListLBTypes(&lb.ZonedAPIListLBTypesRequest{
+		Zone: zone,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	onlyAvailable := d.Get("only_available").(bool)
+	offers := []interface{}(nil)
+	for _, lbType := range res.LBTypes {
+		if onlyAvailable && lbType.StockStatus != lb.LBTypeStockAvailable && lbType.StockStatus != lb.LBTypeStockLowStock {
+			continue
+		}
+
+		offers = append(offers, map[string]interface{}{
+			"name":         lbType.Name,
+			"description":  lbType.Description,
+			"stock_status": lbType.StockStatus.String(),
+			"zone":         lbType.Zone.String(),
+		})
+	}
+
+	d.SetId(zone.String())
+	_ = d.Set("offers", offers)
+	_ = d.Set("zone", zone)
+
+	return nil
+}
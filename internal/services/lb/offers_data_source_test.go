@@ -0,0 +1,64 @@
+package lb_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceOffers_Basic(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "scaleway_lb_offers" "all" {
+						zone = "fr-par-1"
+					}
+
+					data "scaleway_lb_offers" "available" {
+						zone           = "fr-par-1"
+						only_available = true
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_lb_offers.all", "id", "fr-par-1"),
+					resource.TestCheckResourceAttrSet("data.scaleway_lb_offers.all", "offers.0.name"),
+					resource.TestCheckResourceAttrSet("data.scaleway_lb_offers.all", "offers.0.stock_status"),
+					resource.TestCheckResourceAttr("data.scaleway_lb_offers.all", "offers.0.zone", "fr-par-1"),
+					resource.TestCheckResourceAttrSet("data.scaleway_lb_offers.available", "offers.0.name"),
+					isLbOffersInStock("data.scaleway_lb_offers.available"),
+				),
+			},
+		},
+	})
+}
+
+func isLbOffersInStock(n string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		// Offers in low stock can still be ordered
+		for key, value := range rs.Primary.Attributes {
+			if strings.HasSuffix(key, ".stock_status") && value == lbSDK.LBTypeStockOutOfStock.String() {
+				return fmt.Errorf("offer %s is out of stock", rs.Primary.Attributes[strings.TrimSuffix(key, "stock_status")+"name"])
+			}
+		}
+
+		return nil
+	}
+}
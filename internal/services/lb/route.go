@@ -34,13 +34,13 @@ func ResourceRoute() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithZone(),
 				Description:      "The frontend ID origin of redirection",
 			},
 			"backend_id": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithZone(),
 				Description:      "The backend ID destination of redirection",
 			},
 			"match_sni": {
@@ -37,6 +37,9 @@ func testSweepLB(_ string) error {
 		}
 
 		for _, l := range listLBs.LBs {
+			if !acctest.ShouldSweep("scaleway_lb", l.ID, l.Name, l.Tags) {
+				continue
+			}
 			retryInterval := lb.DefaultWaitLBRetryInterval
 
 			if transport.DefaultWaitRetryInterval != nil {
@@ -78,6 +81,9 @@ func testSweepIP(_ string) error {
 
 		for _, ip := range listIPs.IPs {
 			if ip.LBID == nil {
+				if !acctest.ShouldSweep("scaleway_lb_ip", ip.ID, ip.IPAddress, ip.Tags) {
+					continue
+				}
 				err := lbAPI.ReleaseIP(&lbSDK.ZonedAPIReleaseIPRequest{
 					Zone: zone,
 					IPID: ip.ID,
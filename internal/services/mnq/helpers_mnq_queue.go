@@ -2,11 +2,13 @@ package mnq
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -125,6 +127,25 @@ func newNATSJetStreamClient( //nolint:ireturn
 	return js, nil
 }
 
+// FlattenNATSCredentialsLimits returns the limits of the user JWT contained in a credentials file
+func FlattenNATSCredentialsLimits(credentials string) ([]map[string]interface{}, error) {
+	jwt, err := natsjwt.ParseDecoratedJWT([]byte(credentials))
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := natsjwt.DecodeUserClaims(jwt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode nats credentials: %w", err)
+	}
+
+	return []map[string]interface{}{{
+		"subscriptions": int(claims.Limits.Subs),
+		"data":          int(claims.Limits.Data),
+		"payload":       int(claims.Limits.Payload),
+	}}, nil
+}
+
 func splitNATSJWTAndSeed(credentials string) (string, string, error) {
 	jwt, err := natsjwt.ParseDecoratedJWT([]byte(credentials))
 	if err != nil {
@@ -163,7 +184,69 @@ func getSQSAttributeNames() []awstype.QueueAttributeName {
 		attributeNames = append(attributeNames, awstype.QueueAttributeName(attribute))
 	}
 
-	return attributeNames
+	// The redrive policy is a JSON document handled by ExpandSQSRedrivePolicy and FlattenSQSRedrivePolicy
+	return append(attributeNames, awstype.QueueAttributeNameRedrivePolicy)
+}
+
+type sqsRedrivePolicy struct {
+	DeadLetterTargetArn string      `json:"deadLetterTargetArn"`
+	MaxReceiveCount     json.Number `json:"maxReceiveCount"`
+}
+
+// ExpandSQSRedrivePolicy returns the redrive policy of the dead_letter_queue block, an empty policy removes the dead-letter queue
+func ExpandSQSRedrivePolicy(raw interface{}) (string, error) {
+	rawList := raw.([]interface{})
+	if len(rawList) == 0 || rawList[0] == nil {
+		return "", nil
+	}
+	rawDeadLetterQueue := rawList[0].(map[string]interface{})
+
+	region, projectID, queueName, err := DecomposeMNQID(rawDeadLetterQueue["id"].(string))
+	if err != nil {
+		return "", fmt.Errorf("invalid dead-letter queue ID: %w", err)
+	}
+
+	policy, err := json.Marshal(sqsRedrivePolicy{
+		DeadLetterTargetArn: ComposeSQSARN(region, projectID, queueName),
+		MaxReceiveCount:     json.Number(strconv.Itoa(rawDeadLetterQueue["max_receive_count"].(int))),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(policy), nil
+}
+
+// FlattenSQSRedrivePolicy returns the dead_letter_queue block of a redrive policy
+func FlattenSQSRedrivePolicy(rawPolicy string) ([]map[string]interface{}, error) {
+	if rawPolicy == "" {
+		return nil, nil
+	}
+
+	// maxReceiveCount may be returned as a string or as a number
+	policy := struct {
+		DeadLetterTargetArn string          `json:"deadLetterTargetArn"`
+		MaxReceiveCount     json.RawMessage `json:"maxReceiveCount"`
+	}{}
+	err := json.Unmarshal([]byte(rawPolicy), &policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse redrive policy: %w", err)
+	}
+
+	arn, err := decomposeARN(policy.DeadLetterTargetArn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse dead-letter queue ARN: %w", err)
+	}
+
+	maxReceiveCount, err := strconv.Atoi(strings.Trim(string(policy.MaxReceiveCount), `"`))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse redrive policy max receive count: %w", err)
+	}
+
+	return []map[string]interface{}{{
+		"id":                composeMNQID(arn.Region, arn.ProjectID, arn.ResourceName),
+		"max_receive_count": maxReceiveCount,
+	}}, nil
 }
 
 func resourceMNQQueueName(name interface{}, prefix interface{}, isSQS bool, isSQSFifo bool) string {
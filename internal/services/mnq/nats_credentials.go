@@ -44,6 +44,30 @@ func ResourceNatsCredentials() *schema.Resource {
 				Description: "The credentials file",
 				Sensitive:   true,
 			},
+			"limits": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The limits granted by the credentials, -1 meaning unlimited",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subscriptions": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The maximum number of subscriptions",
+						},
+						"data": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The maximum number of bytes",
+						},
+						"payload": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The maximum size of a message payload, in bytes",
+						},
+					},
+				},
+			},
 			"region": regional.Schema(),
 		},
 	}
@@ -93,6 +117,15 @@ func ResourceMNQNatsCredentialsRead(ctx context.Context, d *schema.ResourceData,
 	_ = d.Set("name", credentials.Name)
 	_ = d.Set("region", region)
 
+	// The credentials file is only returned on creation
+	if file := d.Get("file").(string); file != "" {
+		limits, err := FlattenNATSCredentialsLimits(file)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_ = d.Set("limits", limits)
+	}
+
 	return nil
 }
 
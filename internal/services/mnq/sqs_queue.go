@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	awstype "github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -106,6 +107,27 @@ func ResourceSQSQueue() *schema.Resource {
 				ValidateFunc: validation.IntBetween(1024, 262_144),
 				Description:  "The maximum size of a message. Should be in bytes.",
 			},
+			"dead_letter_queue": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The queue receiving the messages that could not be processed",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the dead-letter queue",
+						},
+						"max_receive_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 1_000),
+							Description:  "The number of times a message is delivered to the queue before being moved to the dead-letter queue",
+						},
+					},
+				},
+			},
 			"region":     regional.Schema(),
 			"project_id": account.ProjectIDSchema(),
 
@@ -116,6 +138,11 @@ func ResourceSQSQueue() *schema.Resource {
 				Computed:    true,
 				Description: "The URL of the queue",
 			},
+			"arn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ARN of the queue",
+			},
 		},
 		CustomizeDiff: resourceMNQQueueCustomizeDiff,
 		StateUpgraders: []schema.StateUpgrader{
@@ -164,6 +191,13 @@ func ResourceMNQSQSQueueCreate(ctx context.Context, d *schema.ResourceData, m in
 		return diag.FromErr(err)
 	}
 
+	if rawDeadLetterQueue, ok := d.GetOk("dead_letter_queue"); ok {
+		attributes[string(awstype.QueueAttributeNameRedrivePolicy)], err = ExpandSQSRedrivePolicy(rawDeadLetterQueue)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	input := &sqs.CreateQueueInput{
 		Attributes: attributes,
 		QueueName:  scw.StringPtr(queueName),
@@ -225,6 +259,13 @@ func ResourceMNQSQSQueueRead(ctx context.Context, d *schema.ResourceData, m inte
 	_ = d.Set("region", region)
 	_ = d.Set("project_id", projectID)
 	_ = d.Set("url", types.FlattenStringPtr(queue.QueueUrl))
+	_ = d.Set("arn", ComposeSQSARN(region, projectID, queueName))
+
+	deadLetterQueue, err := FlattenSQSRedrivePolicy(queueAttributes.Attributes[string(awstype.QueueAttributeNameRedrivePolicy)])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("dead_letter_queue", deadLetterQueue)
 
 	for k, v := range values {
 		_ = d.Set(k, v) // lintignore: R001
@@ -262,6 +303,13 @@ func ResourceMNQSQSQueueUpdate(ctx context.Context, d *schema.ResourceData, m in
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("dead_letter_queue") {
+		attributes[string(awstype.QueueAttributeNameRedrivePolicy)], err = ExpandSQSRedrivePolicy(d.Get("dead_letter_queue"))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	_, err = sqsClient.SetQueueAttributes(ctx, &sqs.SetQueueAttributesInput{
 		QueueUrl:   queue.QueueUrl,
 		Attributes: attributes,
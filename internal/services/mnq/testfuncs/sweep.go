@@ -48,6 +48,9 @@ func testSweepSQSCredentials(_ string) error {
 		}
 
 		for _, credentials := range listSqsCredentials.SqsCredentials {
+			if !acctest.ShouldSweep("scaleway_mnq_sqs_credentials", credentials.ID, credentials.Name, nil) {
+				continue
+			}
 			err := mnqAPI.DeleteSqsCredentials(&mnqSDK.SqsAPIDeleteSqsCredentialsRequest{
 				SqsCredentialsID: credentials.ID,
 				Region:           region,
@@ -106,6 +109,9 @@ func testSweepSNSCredentials(_ string) error {
 		}
 
 		for _, credentials := range listSnsCredentials.SnsCredentials {
+			if !acctest.ShouldSweep("scaleway_mnq_sns_credentials", credentials.ID, credentials.Name, nil) {
+				continue
+			}
 			err := mnqAPI.DeleteSnsCredentials(&mnqSDK.SnsAPIDeleteSnsCredentialsRequest{
 				SnsCredentialsID: credentials.ID,
 				Region:           region,
@@ -164,6 +170,9 @@ func testSweepNatsAccount(_ string) error {
 		}
 
 		for _, account := range listNatsAccounts.NatsAccounts {
+			if !acctest.ShouldSweep("scaleway_mnq_nats_account", account.ID, account.Name, nil) {
+				continue
+			}
 			err := mnqAPI.DeleteNatsAccount(&mnqSDK.NatsAPIDeleteNatsAccountRequest{
 				NatsAccountID: account.ID,
 				Region:        region,
@@ -33,6 +33,9 @@ func testSweepMongodbInstance(_ string) error {
 		}
 
 		for _, instance := range listInstance.Instances {
+			if !acctest.ShouldSweep("scaleway_mongodb_instance", instance.ID, instance.Name, instance.Tags) {
+				continue
+			}
 			_, err := mongodbAPI.DeleteInstance(&mongodb.DeleteInstanceRequest{
 				Region:     extractRegion,
 				InstanceID: instance.ID,
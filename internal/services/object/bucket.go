@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 )
 
@@ -76,6 +77,11 @@ func ResourceBucket() *schema.Resource {
 				Description: "API URL of the bucket",
 				Computed:    true,
 			},
+			"regional_domain_name": {
+				Type:        schema.TypeString,
+				Description: "Regional domain name of the bucket, without scheme",
+				Computed:    true,
+			},
 			"cors_rule": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -90,7 +96,10 @@ func ResourceBucket() *schema.Resource {
 						"allowed_methods": {
 							Type:     schema.TypeList,
 							Required: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"GET", "PUT", "POST", "DELETE", "HEAD"}, true),
+							},
 						},
 						"allowed_origins": {
 							Type:     schema.TypeList,
@@ -531,6 +540,7 @@ func resourceObjectBucketRead(ctx context.Context, d *schema.ResourceData, m int
 
 	_ = d.Set("endpoint", objectBucketEndpointURL(bucketName, region))
 	_ = d.Set("api_endpoint", objectBucketAPIEndpointURL(region))
+	_ = d.Set("regional_domain_name", objectBucketRegionalDomainName(bucketName, region))
 
 	// Read the CORS
 	corsResponse, err := s3Client.GetBucketCors(ctx, &s3.GetBucketCorsInput{
@@ -673,7 +683,7 @@ func resourceObjectBucketDelete(ctx context.Context, d *schema.ResourceData, m i
 		}
 
 		if IsS3Err(err, ErrCodeBucketNotEmpty, "") {
-			if d.Get("force_destroy").(bool) {
+			if d.Get("force_destroy").(bool) || m.(*meta.Meta).Features().ObjectBucketPurgeOnDestroy {
 				nObjectDeleted, err = emptyBucket(ctx, s3Client, bucketName, true)
 				if err != nil {
 					return diag.FromErr(fmt.Errorf("error S3 bucket force_destroy: %s", err))
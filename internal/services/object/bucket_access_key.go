@@ -0,0 +1,278 @@
+package object
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	iamSDK "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/iam"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+const bucketPolicyVersion = "2023-04-17"
+
+var (
+	bucketAccessKeyReadActions  = []string{"s3:ListBucket", "s3:GetObject"}
+	bucketAccessKeyWriteActions = []string{"s3:PutObject", "s3:DeleteObject"}
+)
+
+func ResourceBucketAccessKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObjectBucketAccessKeyCreate,
+		ReadContext:   resourceObjectBucketAccessKeyRead,
+		UpdateContext: resourceObjectBucketAccessKeyUpdate,
+		DeleteContext: resourceObjectBucketAccessKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the IAM application holding the access key",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the IAM application and access key",
+			},
+			"bucket_names": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The names of the buckets to generate a bucket policy for",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only grant read access to the buckets in the generated policies",
+			},
+			"expires_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Description:      "The date and time of the expiration of the access key. Cannot be changed afterwards",
+				ValidateDiagFunc: verify.IsDate(),
+				DiffSuppressFunc: dsf.TimeRFC3339,
+			},
+			"application_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the IAM application holding the access key",
+			},
+			"access_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The access key",
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret key",
+			},
+			"bucket_policies": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The bucket policy granting the access key access to each bucket, indexed by bucket name. They are not applied by this resource",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"project_id":      account.ProjectIDSchema(),
+			"organization_id": account.OrganizationIDOptionalSchema(),
+		},
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			if diff.HasChanges("bucket_names", "read_only") {
+				return diff.SetNewComputed("bucket_policies")
+			}
+			return nil
+		},
+	}
+}
+
+func resourceObjectBucketAccessKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := iam.NewAPI(m)
+
+	app, err := api.CreateApplication(&iamSDK.CreateApplicationRequest{
+		Name:           types.ExpandOrGenerateString(d.Get("name"), "bucket-access-key"),
+		OrganizationID: d.Get("organization_id").(string),
+		Description:    d.Get("description").(string),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(app.ID)
+
+	key, err := api.CreateAPIKey(&iamSDK.CreateAPIKeyRequest{
+		ApplicationID:    scw.StringPtr(app.ID),
+		ExpiresAt:        types.ExpandTimePtr(d.Get("expires_at")),
+		DefaultProjectID: types.ExpandStringPtr(d.Get("project_id")),
+		Description:      d.Get("description").(string),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("access_key", key.AccessKey)
+	_ = d.Set("secret_key", key.SecretKey)
+
+	return resourceObjectBucketAccessKeyRead(ctx, d, m)
+}
+
+func resourceObjectBucketAccessKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := iam.NewAPI(m)
+
+	app, err := api.GetApplication(&iamSDK.GetApplicationRequest{
+		ApplicationID: d.Id(),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if httperrors.Is404(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	key, err := getBucketAccessKeyAPIKey(ctx, api, app.ID, d.Get("access_key").(string))
+	if err != nil {
+		if httperrors.Is404(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if key == nil {
+		d.SetId("")
+		return nil
+	}
+
+	policies, err := BuildBucketAccessKeyPolicies(app.ID, types.ExpandStrings(d.Get("bucket_names")), d.Get("read_only").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("name", app.Name)
+	_ = d.Set("description", app.Description)
+	_ = d.Set("organization_id", app.OrganizationID)
+	_ = d.Set("application_id", app.ID)
+	_ = d.Set("access_key", key.AccessKey)
+	_ = d.Set("project_id", key.DefaultProjectID)
+	_ = d.Set("expires_at", types.FlattenTime(key.ExpiresAt))
+	_ = d.Set("bucket_policies", policies)
+
+	return nil
+}
+
+func resourceObjectBucketAccessKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := iam.NewAPI(m)
+
+	if d.HasChanges("name", "description") {
+		_, err := api.UpdateApplication(&iamSDK.UpdateApplicationRequest{
+			ApplicationID: d.Id(),
+			Name:          types.ExpandStringPtr(d.Get("name")),
+			Description:   types.ExpandUpdatedStringPtr(d.Get("description")),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("description") {
+		_, err := api.UpdateAPIKey(&iamSDK.UpdateAPIKeyRequest{
+			AccessKey:   d.Get("access_key").(string),
+			Description: types.ExpandUpdatedStringPtr(d.Get("description")),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceObjectBucketAccessKeyRead(ctx, d, m)
+}
+
+func resourceObjectBucketAccessKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := iam.NewAPI(m)
+
+	// Deleting the application also revokes its API keys
+	err := api.DeleteApplication(&iamSDK.DeleteApplicationRequest{
+		ApplicationID: d.Id(),
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// getBucketAccessKeyAPIKey returns the API key of the application, nil if it has been revoked.
+// The access key is unknown when the resource is imported, it is then looked up among the keys of the application.
+func getBucketAccessKeyAPIKey(ctx context.Context, api *iamSDK.API, applicationID string, accessKey string) (*iamSDK.APIKey, error) {
+	if accessKey != "" {
+		return api.GetAPIKey(&iamSDK.GetAPIKeyRequest{
+			AccessKey: accessKey,
+		}, scw.WithContext(ctx))
+	}
+
+	res, err := api.ListAPIKeys(&iamSDK.ListAPIKeysRequest{
+		ApplicationID: scw.StringPtr(applicationID),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if len(res.APIKeys) == 0 {
+		return nil, nil
+	}
+
+	return res.APIKeys[0], nil
+}
+
+// BuildBucketAccessKeyPolicies returns, for each bucket, a bucket policy granting the application access to the bucket and its objects
+func BuildBucketAccessKeyPolicies(applicationID string, bucketNames []string, readOnly bool) (map[string]interface{}, error) {
+	actions := append([]string{}, bucketAccessKeyReadActions...)
+	if !readOnly {
+		actions = append(actions, bucketAccessKeyWriteActions...)
+	}
+
+	policies := make(map[string]interface{}, len(bucketNames))
+	for _, bucketName := range bucketNames {
+		policy := map[string]interface{}{
+			"Version": bucketPolicyVersion,
+			"Id":      fmt.Sprintf("%s-access-key", bucketName),
+			"Statement": []map[string]interface{}{
+				{
+					"Sid":    "ScopedAccessKey",
+					"Effect": "Allow",
+					"Principal": map[string]interface{}{
+						"SCW": "application_id:" + applicationID,
+					},
+					"Action":   actions,
+					"Resource": []string{bucketName, bucketName + "/*"},
+				},
+			},
+		}
+
+		rawPolicy, err := json.Marshal(policy)
+		if err != nil {
+			return nil, err
+		}
+		policies[bucketName] = string(rawPolicy)
+	}
+
+	return policies, nil
+}
@@ -0,0 +1,154 @@
+package object_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	iamSDK "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/iam"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/object"
+	objectchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/object/testfuncs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildBucketAccessKeyPolicies(t *testing.T) {
+	applicationID := "11111111-1111-1111-1111-111111111111"
+
+	policies, err := object.BuildBucketAccessKeyPolicies(applicationID, []string{"bucket-a", "bucket-b"}, true)
+	require.NoError(t, err)
+	require.Len(t, policies, 2)
+	assert.JSONEq(t, `{
+		"Version": "2023-04-17",
+		"Id": "bucket-a-access-key",
+		"Statement": [{
+			"Sid": "ScopedAccessKey",
+			"Effect": "Allow",
+			"Principal": {"SCW": "application_id:11111111-1111-1111-1111-111111111111"},
+			"Action": ["s3:ListBucket", "s3:GetObject"],
+			"Resource": ["bucket-a", "bucket-a/*"]
+		}]
+	}`, policies["bucket-a"].(string))
+
+	policies, err = object.BuildBucketAccessKeyPolicies(applicationID, []string{"bucket-a"}, false)
+	require.NoError(t, err)
+	assert.Contains(t, policies["bucket-a"], `"Action":["s3:ListBucket","s3:GetObject","s3:PutObject","s3:DeleteObject"]`)
+}
+
+func TestAccObjectBucketAccessKey_Basic(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	bucketName := sdkacctest.RandomWithPrefix("tf-tests-scaleway-object-bucket-access-key")
+	resourceName := "scaleway_object_bucket_access_key.main"
+
+	config := func(readOnly bool) string {
+		return fmt.Sprintf(`
+			resource "scaleway_object_bucket" "main" {
+				name = %[1]q
+				region = %[2]q
+			}
+
+			resource "scaleway_object_bucket_access_key" "main" {
+				name         = "tf-tests-object-bucket-access-key"
+				bucket_names = [scaleway_object_bucket.main.name]
+				read_only    = %[3]t
+			}
+
+			resource "scaleway_object_bucket_policy" "main" {
+				bucket = scaleway_object_bucket.main.id
+				policy = scaleway_object_bucket_access_key.main.bucket_policies[scaleway_object_bucket.main.name]
+			}`, bucketName, objectTestsMainRegion, readOnly)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			isBucketAccessKeyDestroyed(tt),
+			objectchecks.IsBucketDestroyed(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config(true),
+				Check: resource.ComposeTestCheckFunc(
+					isBucketAccessKeyPresent(tt, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-tests-object-bucket-access-key"),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "access_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_key"),
+					resource.TestCheckResourceAttr(resourceName, "bucket_policies.%", "1"),
+					resource.TestCheckResourceAttrPair("scaleway_object_bucket_policy.main", "policy", resourceName, "bucket_policies."+bucketName),
+				),
+			},
+			{
+				Config: config(false),
+				Check: resource.ComposeTestCheckFunc(
+					isBucketAccessKeyPresent(tt, resourceName),
+					resource.TestMatchResourceAttr(resourceName, "bucket_policies."+bucketName, regexp.MustCompile(`"s3:PutObject"`)),
+					resource.TestCheckResourceAttrPair("scaleway_object_bucket_policy.main", "policy", resourceName, "bucket_policies."+bucketName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_key", "bucket_names", "read_only", "bucket_policies"},
+			},
+		},
+	})
+}
+
+func isBucketAccessKeyPresent(tt *acctest.TestTools, n string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		iamAPI := iam.NewAPI(tt.Meta)
+
+		_, err := iamAPI.GetAPIKey(&iamSDK.GetAPIKeyRequest{
+			AccessKey: rs.Primary.Attributes["access_key"],
+		})
+		if err != nil {
+			return fmt.Errorf("could not find access key: %w", err)
+		}
+
+		return nil
+	}
+}
+
+func isBucketAccessKeyDestroyed(tt *acctest.TestTools) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for _, rs := range state.RootModule().Resources {
+			if rs.Type != "scaleway_object_bucket_access_key" {
+				continue
+			}
+
+			iamAPI := iam.NewAPI(tt.Meta)
+
+			_, err := iamAPI.GetApplication(&iamSDK.GetApplicationRequest{
+				ApplicationID: rs.Primary.ID,
+			})
+			if err == nil {
+				return fmt.Errorf("application of bucket access key (%s) still exists", rs.Primary.ID)
+			}
+
+			if !httperrors.Is404(err) {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,314 @@
+package object
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3Types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+)
+
+func ResourceBucketNotification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObjectBucketNotificationCreate,
+		ReadContext:   resourceObjectBucketNotificationRead,
+		UpdateContext: resourceObjectBucketNotificationUpdate,
+		DeleteContext: resourceObjectBucketNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringLenBetween(1, 63),
+				Description:      "The bucket's name or regional ID.",
+				DiffSuppressFunc: dsf.Locality,
+			},
+			"queue": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Notification configurations sending the events to a Messaging and Queuing SQS queue",
+				Elem:        bucketNotificationSchema("queue_arn", "The ARN of the SQS queue receiving the events"),
+			},
+			"topic": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Notification configurations sending the events to a Messaging and Queuing SNS topic",
+				Elem:        bucketNotificationSchema("topic_arn", "The ARN of the SNS topic receiving the events"),
+			},
+			"region":     regional.Schema(),
+			"project_id": account.ProjectIDSchema(),
+		},
+	}
+}
+
+func bucketNotificationSchema(targetKey string, targetDescription string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier of the notification configuration",
+			},
+			targetKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: targetDescription,
+			},
+			"events": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The events sending a notification, e.g. s3:ObjectCreated:*",
+			},
+			"filter_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only notify events on objects whose key starts with this prefix",
+			},
+			"filter_suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only notify events on objects whose key ends with this suffix",
+			},
+		},
+	}
+}
+
+func resourceObjectBucketNotificationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn, region, err := s3ClientWithRegion(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	regionalID := regional.ExpandID(d.Get("bucket"))
+	bucket := regionalID.ID
+	bucketRegion := regionalID.Region
+
+	if bucketRegion != "" && bucketRegion != region {
+		conn, err = s3ClientForceRegion(ctx, d, m, bucketRegion.String())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		region = bucketRegion
+	}
+
+	_, err = conn.PutBucketNotificationConfiguration(ctx, &s3.PutBucketNotificationConfigurationInput{
+		Bucket:                    aws.String(bucket),
+		NotificationConfiguration: expandBucketNotificationConfiguration(d),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating object bucket (%s) notification configuration: %w", bucket, err))
+	}
+
+	d.SetId(regional.NewIDString(region, bucket))
+
+	return resourceObjectBucketNotificationRead(ctx, d, m)
+}
+
+func resourceObjectBucketNotificationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn, region, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := conn.GetBucketNotificationConfiguration(ctx, &s3.GetBucketNotificationConfigurationInput{
+		Bucket: aws.String(bucket),
+	})
+	if !d.IsNewResource() && errors.As(err, new(*s3Types.NoSuchBucket)) {
+		tflog.Warn(ctx, fmt.Sprintf("Object Bucket Notification Configuration (%s) not found, removing from state", d.Id()))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading object bucket (%s) notification configuration: %w", bucket, err))
+	}
+
+	_ = d.Set("bucket", bucket)
+	_ = d.Set("region", region)
+	_ = d.Set("queue", flattenBucketNotificationQueueConfigurations(output.QueueConfigurations))
+	_ = d.Set("topic", flattenBucketNotificationTopicConfigurations(output.TopicConfigurations))
+
+	acl, err := conn.GetBucketAcl(ctx, &s3.GetBucketAclInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
+	}
+	_ = d.Set("project_id", NormalizeOwnerID(acl.Owner.ID))
+
+	return nil
+}
+
+func resourceObjectBucketNotificationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn, _, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = conn.PutBucketNotificationConfiguration(ctx, &s3.PutBucketNotificationConfigurationInput{
+		Bucket:                    aws.String(bucket),
+		NotificationConfiguration: expandBucketNotificationConfiguration(d),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating object bucket notification configuration (%s): %w", d.Id(), err))
+	}
+
+	return resourceObjectBucketNotificationRead(ctx, d, m)
+}
+
+func resourceObjectBucketNotificationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn, _, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// An empty notification configuration disables all the notifications of the bucket.
+	_, err = conn.PutBucketNotificationConfiguration(ctx, &s3.PutBucketNotificationConfigurationInput{
+		Bucket:                    aws.String(bucket),
+		NotificationConfiguration: &s3Types.NotificationConfiguration{},
+	})
+	if errors.As(err, new(*s3Types.NoSuchBucket)) {
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting object bucket notification configuration (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func expandBucketNotificationConfiguration(d *schema.ResourceData) *s3Types.NotificationConfiguration {
+	config := &s3Types.NotificationConfiguration{}
+
+	for _, raw := range d.Get("queue").([]interface{}) {
+		queue := raw.(map[string]interface{})
+		config.QueueConfigurations = append(config.QueueConfigurations, s3Types.QueueConfiguration{
+			Id:       expandBucketNotificationID(queue["id"]),
+			QueueArn: aws.String(queue["queue_arn"].(string)),
+			Events:   expandBucketNotificationEvents(queue["events"]),
+			Filter:   expandBucketNotificationFilter(queue),
+		})
+	}
+
+	for _, raw := range d.Get("topic").([]interface{}) {
+		topic := raw.(map[string]interface{})
+		config.TopicConfigurations = append(config.TopicConfigurations, s3Types.TopicConfiguration{
+			Id:       expandBucketNotificationID(topic["id"]),
+			TopicArn: aws.String(topic["topic_arn"].(string)),
+			Events:   expandBucketNotificationEvents(topic["events"]),
+			Filter:   expandBucketNotificationFilter(topic),
+		})
+	}
+
+	return config
+}
+
+func expandBucketNotificationID(i interface{}) *string {
+	id, _ := i.(string)
+	if id == "" {
+		return nil
+	}
+
+	return aws.String(id)
+}
+
+func expandBucketNotificationEvents(i interface{}) []s3Types.Event {
+	events := []s3Types.Event(nil)
+	for _, event := range i.(*schema.Set).List() {
+		events = append(events, s3Types.Event(event.(string)))
+	}
+
+	return events
+}
+
+func expandBucketNotificationFilter(notification map[string]interface{}) *s3Types.NotificationConfigurationFilter {
+	rules := []s3Types.FilterRule(nil)
+
+	if prefix, _ := notification["filter_prefix"].(string); prefix != "" {
+		rules = append(rules, s3Types.FilterRule{
+			Name:  s3Types.FilterRuleNamePrefix,
+			Value: aws.String(prefix),
+		})
+	}
+
+	if suffix, _ := notification["filter_suffix"].(string); suffix != "" {
+		rules = append(rules, s3Types.FilterRule{
+			Name:  s3Types.FilterRuleNameSuffix,
+			Value: aws.String(suffix),
+		})
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	return &s3Types.NotificationConfigurationFilter{
+		Key: &s3Types.S3KeyFilter{
+			FilterRules: rules,
+		},
+	}
+}
+
+func flattenBucketNotificationQueueConfigurations(configurations []s3Types.QueueConfiguration) []interface{} {
+	queues := make([]interface{}, 0, len(configurations))
+	for _, configuration := range configurations {
+		queue := flattenBucketNotification(configuration.Id, configuration.Events, configuration.Filter)
+		queue["queue_arn"] = aws.ToString(configuration.QueueArn)
+		queues = append(queues, queue)
+	}
+
+	return queues
+}
+
+func flattenBucketNotificationTopicConfigurations(configurations []s3Types.TopicConfiguration) []interface{} {
+	topics := make([]interface{}, 0, len(configurations))
+	for _, configuration := range configurations {
+		topic := flattenBucketNotification(configuration.Id, configuration.Events, configuration.Filter)
+		topic["topic_arn"] = aws.ToString(configuration.TopicArn)
+		topics = append(topics, topic)
+	}
+
+	return topics
+}
+
+func flattenBucketNotification(id *string, events []s3Types.Event, filter *s3Types.NotificationConfigurationFilter) map[string]interface{} {
+	flatEvents := make([]interface{}, 0, len(events))
+	for _, event := range events {
+		flatEvents = append(flatEvents, string(event))
+	}
+
+	notification := map[string]interface{}{
+		"id":     aws.ToString(id),
+		"events": flatEvents,
+	}
+
+	if filter != nil && filter.Key != nil {
+		// S3 compatible endpoints may return the names capitalized, e.g. Prefix
+		for _, rule := range filter.Key.FilterRules {
+			switch {
+			case strings.EqualFold(string(rule.Name), string(s3Types.FilterRuleNamePrefix)):
+				notification["filter_prefix"] = aws.ToString(rule.Value)
+			case strings.EqualFold(string(rule.Name), string(s3Types.FilterRuleNameSuffix)):
+				notification["filter_suffix"] = aws.ToString(rule.Value)
+			}
+		}
+	}
+
+	return notification
+}
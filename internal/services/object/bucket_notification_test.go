@@ -0,0 +1,84 @@
+package object_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/object"
+	objectchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/object/testfuncs"
+)
+
+func TestAccObjectBucketNotification_Basic(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	bucketName := sdkacctest.RandomWithPrefix("tf-tests-scaleway-object-bucket-notification")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        object.ErrorCheck(t, EndpointsID),
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      objectchecks.IsBucketDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "main" {
+						name   = %[1]q
+						region = %[2]q
+					}
+
+					resource "scaleway_mnq_sqs" "main" {
+						region = %[2]q
+					}
+
+					resource "scaleway_mnq_sqs_credentials" "main" {
+						region = scaleway_mnq_sqs.main.region
+						permissions {
+							can_manage  = true
+							can_publish = true
+							can_receive = true
+						}
+					}
+
+					resource "scaleway_mnq_sqs_queue" "main" {
+						region     = scaleway_mnq_sqs.main.region
+						name       = "object-events"
+						access_key = scaleway_mnq_sqs_credentials.main.access_key
+						secret_key = scaleway_mnq_sqs_credentials.main.secret_key
+					}
+
+					resource "scaleway_object_bucket_notification" "main" {
+						bucket = scaleway_object_bucket.main.id
+
+						queue {
+							id            = "images"
+							queue_arn     = scaleway_mnq_sqs_queue.main.arn
+							events        = ["s3:ObjectCreated:*"]
+							filter_prefix = "images/"
+							filter_suffix = ".jpg"
+						}
+					}
+				`, bucketName, objectTestsMainRegion),
+				Check: resource.ComposeTestCheckFunc(
+					objectchecks.CheckBucketExists(tt, "scaleway_object_bucket.main", true),
+					resource.TestCheckResourceAttr("scaleway_object_bucket_notification.main", "queue.#", "1"),
+					resource.TestCheckResourceAttr("scaleway_object_bucket_notification.main", "queue.0.id", "images"),
+					resource.TestCheckResourceAttrPair("scaleway_object_bucket_notification.main", "queue.0.queue_arn", "scaleway_mnq_sqs_queue.main", "arn"),
+					// The API returns the filter rule names capitalized
+					resource.TestCheckResourceAttr("scaleway_object_bucket_notification.main", "queue.0.filter_prefix", "images/"),
+					resource.TestCheckResourceAttr("scaleway_object_bucket_notification.main", "queue.0.filter_suffix", ".jpg"),
+				),
+			},
+			{
+				ResourceName:      "scaleway_object_bucket_notification.main",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
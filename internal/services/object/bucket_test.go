@@ -507,6 +507,9 @@ func TestAccObjectBucket_Cors_Update(t *testing.T) {
 							},
 						},
 					),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.expose_headers.1", "ETag"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.max_age_seconds", "3000"),
 				),
 			},
 			{
@@ -224,7 +224,11 @@ func ExpandObjectBucketTags(tags interface{}) []s3Types.Tag {
 }
 
 func objectBucketEndpointURL(bucketName string, region scw.Region) string {
-	return fmt.Sprintf("https://%s.s3.%s.scw.cloud", bucketName, region)
+	return "https://" + objectBucketRegionalDomainName(bucketName, region)
+}
+
+func objectBucketRegionalDomainName(bucketName string, region scw.Region) string {
+	return fmt.Sprintf("%s.s3.%s.scw.cloud", bucketName, region)
 }
 
 func objectBucketAPIEndpointURL(region scw.Region) string {
@@ -281,7 +285,7 @@ func flattenBucketCORS(corsResponse interface{}) []interface{} {
 				rule["expose_headers"] = ruleObject.ExposeHeaders
 			}
 			if ruleObject.MaxAgeSeconds != nil {
-				rule["max_age_seconds"] = ruleObject.MaxAgeSeconds
+				rule["max_age_seconds"] = int(*ruleObject.MaxAgeSeconds)
 			}
 			corsRules = append(corsRules, rule)
 		}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -114,6 +115,35 @@ func ResourceObject() *schema.Resource {
 				Description:  "Customer's encryption keys to encrypt data (SSE-C)",
 				ValidateFunc: validation.StringLenBetween(32, 32),
 			},
+			"object_lock_legal_hold_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(s3Types.ObjectLockLegalHoldStatusOn),
+					string(s3Types.ObjectLockLegalHoldStatusOff),
+				}, false),
+				Description: "Legal hold status of the object, ON or OFF. Object lock must be enabled on the bucket",
+			},
+			"object_lock_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(s3Types.ObjectLockModeGovernance),
+					string(s3Types.ObjectLockModeCompliance),
+				}, false),
+				RequiredWith: []string{"object_lock_retain_until_date"},
+				Description:  "Retention mode of the object, GOVERNANCE or COMPLIANCE. Object lock must be enabled on the bucket",
+			},
+			"object_lock_retain_until_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				RequiredWith: []string{"object_lock_mode"},
+				Description:  "Date until which the object is retained, in RFC3339 format",
+			},
 			"region":     regional.Schema(),
 			"project_id": account.ProjectIDSchema(),
 		},
@@ -167,6 +197,8 @@ func resourceObjectCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		req.SSECustomerKey = encryption
 	}
 
+	req.ObjectLockLegalHoldStatus, req.ObjectLockMode, req.ObjectLockRetainUntilDate = expandObjectLock(d)
+
 	if filePath, hasFile := d.GetOk("file"); hasFile {
 		file, err := os.Open(filePath.(string))
 		if err != nil {
@@ -236,6 +268,16 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 	bucketUpdated := regional.ExpandID(d.Get("bucket")).ID
 	keyUpdated := d.Get("key").(string)
 
+	// A lock change alone is applied to the current version, any other change uploads a new version carrying the lock.
+	if !d.HasChangesExcept("object_lock_legal_hold_status", "object_lock_mode", "object_lock_retain_until_date") {
+		err = updateObjectLock(ctx, s3Client, d, bucket, key)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		return resourceObjectRead(ctx, d, m)
+	}
+
 	if d.HasChanges("file", "hash") {
 		req := &s3.PutObjectInput{
 			Bucket:       types.ExpandStringPtr(bucketUpdated),
@@ -244,6 +286,7 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 			Metadata:     types.ExpandMapStringString(d.Get("metadata")),
 			ACL:          s3Types.ObjectCannedACL(d.Get("visibility").(string)),
 		}
+		req.ObjectLockLegalHoldStatus, req.ObjectLockMode, req.ObjectLockRetainUntilDate = expandObjectLock(d)
 		if encryptionKey, ok := d.GetOk("sse_customer_key"); ok {
 			digestMD5, encryption, err := EncryptCustomerKey(encryptionKey.(string))
 			if err != nil {
@@ -272,6 +315,7 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 			Metadata:     types.ExpandMapStringString(d.Get("metadata")),
 			ACL:          s3Types.ObjectCannedACL(d.Get("visibility").(string)),
 		}
+		req.ObjectLockLegalHoldStatus, req.ObjectLockMode, req.ObjectLockRetainUntilDate = expandObjectLock(d)
 		if encryptionKey, ok := d.GetOk("sse_customer_key"); ok {
 			digestMD5, encryption, err := EncryptCustomerKey(encryptionKey.(string))
 			if err != nil {
@@ -369,6 +413,10 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, m interface
 		return diag.FromErr(err)
 	}
 
+	_ = d.Set("object_lock_legal_hold_status", string(obj.ObjectLockLegalHoldStatus))
+	_ = d.Set("object_lock_mode", string(obj.ObjectLockMode))
+	_ = d.Set("object_lock_retain_until_date", types.FlattenTime(obj.ObjectLockRetainUntilDate))
+
 	if objectIsPublic(acl) {
 		_ = d.Set("visibility", s3Types.ObjectCannedACLPublicRead)
 	} else {
@@ -400,6 +448,54 @@ func resourceObjectDelete(ctx context.Context, d *schema.ResourceData, m interfa
 	return nil
 }
 
+// expandObjectLock returns the legal hold status, the retention mode and the retention date set in the configuration.
+func expandObjectLock(d *schema.ResourceData) (s3Types.ObjectLockLegalHoldStatus, s3Types.ObjectLockMode, *time.Time) {
+	legalHoldStatus := s3Types.ObjectLockLegalHoldStatus(d.Get("object_lock_legal_hold_status").(string))
+
+	lockMode, ok := d.GetOk("object_lock_mode")
+	if !ok {
+		return legalHoldStatus, "", nil
+	}
+
+	return legalHoldStatus, s3Types.ObjectLockMode(lockMode.(string)), types.ExpandTimePtr(d.Get("object_lock_retain_until_date"))
+}
+
+// updateObjectLock updates the legal hold and the retention of the current version of an object.
+func updateObjectLock(ctx context.Context, s3Client *s3.Client, d *schema.ResourceData, bucket, key string) error {
+	if d.HasChange("object_lock_legal_hold_status") {
+		if legalHoldStatus, ok := d.GetOk("object_lock_legal_hold_status"); ok {
+			_, err := s3Client.PutObjectLegalHold(ctx, &s3.PutObjectLegalHoldInput{
+				Bucket: types.ExpandStringPtr(bucket),
+				Key:    types.ExpandStringPtr(key),
+				LegalHold: &s3Types.ObjectLockLegalHold{
+					Status: s3Types.ObjectLockLegalHoldStatus(legalHoldStatus.(string)),
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update legal hold of object %s: %w", key, err)
+			}
+		}
+	}
+
+	if d.HasChanges("object_lock_mode", "object_lock_retain_until_date") {
+		if lockMode, ok := d.GetOk("object_lock_mode"); ok {
+			_, err := s3Client.PutObjectRetention(ctx, &s3.PutObjectRetentionInput{
+				Bucket: types.ExpandStringPtr(bucket),
+				Key:    types.ExpandStringPtr(key),
+				Retention: &s3Types.ObjectLockRetention{
+					Mode:            s3Types.ObjectLockRetentionMode(lockMode.(string)),
+					RetainUntilDate: types.ExpandTimePtr(d.Get("object_lock_retain_until_date")),
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update retention of object %s: %w", key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func objectID(bucket, key string) string {
 	return fmt.Sprintf("%s/%s", bucket, key)
 }
@@ -836,3 +836,109 @@ func testAccCheckObjectExists(tt *acctest.TestTools, n string) resource.TestChec
 		return nil
 	}
 }
+
+func TestAccObject_LegalHold(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	bucketName := sdkacctest.RandomWithPrefix("test-acc-scaleway-object-legal-hold")
+	config := func(legalHoldStatus string, metadata string) string {
+		return fmt.Sprintf(`
+			resource "scaleway_object_bucket" "base-01" {
+				name                = "%[1]s"
+				region              = "%[2]s"
+				object_lock_enabled = true
+				force_destroy       = true
+			}
+
+			resource scaleway_object "file" {
+				bucket = scaleway_object_bucket.base-01.id
+				key    = "myfile"
+				file   = "testfixture/empty.qcow2"
+
+				object_lock_legal_hold_status = "%[3]s"
+
+				metadata = {
+					key = "%[4]s"
+				}
+			}
+		`, bucketName, objectTestsMainRegion, legalHoldStatus, metadata)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        object.ErrorCheck(t, EndpointsID),
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			objectchecks.IsObjectDestroyed(tt),
+			objectchecks.IsBucketDestroyed(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config("ON", "value"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(tt, "scaleway_object.file"),
+					resource.TestCheckResourceAttr("scaleway_object.file", "object_lock_legal_hold_status", "ON"),
+					testAccCheckObjectVersionsCount(tt, "scaleway_object.file", 1),
+				),
+			},
+			{
+				// Changing only the lock updates the current version without uploading a new one
+				Config: config("OFF", "value"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_object.file", "object_lock_legal_hold_status", "OFF"),
+					testAccCheckObjectVersionsCount(tt, "scaleway_object.file", 1),
+				),
+			},
+			{
+				// A new version created by any other change keeps the lock
+				Config: config("ON", "other_value"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_object.file", "object_lock_legal_hold_status", "ON"),
+					resource.TestCheckResourceAttr("scaleway_object.file", "metadata.key", "other_value"),
+					testAccCheckObjectVersionsCount(tt, "scaleway_object.file", 2),
+				),
+			},
+			{
+				// The legal hold is removed so the versions can be deleted with the bucket
+				Config: config("OFF", "other_value"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_object.file", "object_lock_legal_hold_status", "OFF"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectVersionsCount(tt *acctest.TestTools, n string, expected int) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		ctx := context.Background()
+		rs, ok := state.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		key := rs.Primary.Attributes["key"]
+
+		regionalID := regional.ExpandID(rs.Primary.Attributes["bucket"])
+		s3Client, err := object.NewS3ClientFromMeta(ctx, tt.Meta, regionalID.Region.String())
+		if err != nil {
+			return err
+		}
+
+		versions, err := s3Client.ListObjectVersions(ctx, &s3.ListObjectVersionsInput{
+			Bucket: scw.StringPtr(regionalID.ID),
+			Prefix: scw.StringPtr(key),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(versions.Versions) != expected {
+			return fmt.Errorf("object %s has %d versions, expected %d", key, len(versions.Versions), expected)
+		}
+
+		return nil
+	}
+}
@@ -35,6 +35,9 @@ func testSweepStorageObjectBucket(_ string) error {
 		for _, bucket := range listBucketResponse.Buckets {
 			logging.L.Debugf("Deleting %q bucket", *bucket.Name)
 			if acctest.IsTestResource(*bucket.Name) {
+				if !acctest.ShouldSweep("scaleway_object_bucket", *bucket.Name, *bucket.Name, nil) {
+					continue
+				}
 				_, err := s3client.DeleteBucket(ctx, &s3.DeleteBucketInput{
 					Bucket: bucket.Name,
 				})
@@ -3,13 +3,18 @@ package rdb
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	secret "github.com/scaleway/scaleway-sdk-go/api/secret/v1beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
@@ -78,6 +83,50 @@ func rdbPrivilegeUpgradeV1SchemaType() cty.Type {
 	})
 }
 
+// customizeDiffVolumeSizeInGB refuses plans that would shrink the volume of an existing instance, as it can only grow
+func customizeDiffVolumeSizeInGB(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("volume_size_in_gb") || !diff.NewValueKnown("volume_size_in_gb") {
+		return nil
+	}
+
+	oldSize, newSize := diff.GetChange("volume_size_in_gb")
+	if newSize.(int) != 0 && newSize.(int) < oldSize.(int) {
+		return fmt.Errorf("volume_size_in_gb cannot be decreased (from %d GB to %d GB): the volume of a database instance can only grow", oldSize.(int), newSize.(int))
+	}
+
+	return nil
+}
+
+// customizeDiffPrivateNetworkIPConfig refuses private networks configured with neither a static ip_net nor IPAM at plan time
+func customizeDiffPrivateNetworkIPConfig(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	privateNetworks := rawConfig.GetAttr("private_network")
+	if privateNetworks.IsNull() || !privateNetworks.IsKnown() {
+		return nil
+	}
+
+	for _, pn := range privateNetworks.AsValueSlice() {
+		if pn.IsNull() || !pn.IsKnown() {
+			continue
+		}
+
+		ipNet, enableIpam := pn.GetAttr("ip_net"), pn.GetAttr("enable_ipam")
+		if !ipNet.IsKnown() || !enableIpam.IsKnown() {
+			continue
+		}
+
+		if ipNet.IsNull() && (enableIpam.IsNull() || enableIpam.False()) {
+			return errors.New("private_network requires either a static `ip_net` or `enable_ipam = true`")
+		}
+	}
+
+	return nil
+}
+
 func getIPConfigCreate(d *schema.ResourceData, ipFieldName string) (ipamConfig *bool, staticConfig *string) {
 	enableIpam, enableIpamSet := d.GetOk("private_network.0.enable_ipam")
 	if enableIpamSet {
@@ -100,3 +149,60 @@ func getIPConfigUpdate(d *schema.ResourceData, ipFieldName string) (ipamConfig *
 	}
 	return ipamConfig, staticConfig
 }
+
+// secretRegionAndID returns the region and ID of a secret, defaulting to the given region if the ID is not regional
+func secretRegionAndID(defaultRegion scw.Region, secretID string) (scw.Region, string) {
+	regionalID := regional.ExpandID(secretID)
+	if regionalID.Region == "" {
+		return defaultRegion, locality.ExpandID(secretID)
+	}
+
+	return regionalID.Region, regionalID.ID
+}
+
+// getUserPasswordSecretVersion returns the version of the secret matching the given revision
+func getUserPasswordSecretVersion(ctx context.Context, m interface{}, defaultRegion scw.Region, secretID string, revision string) (*secret.SecretVersion, error) {
+	region, id := secretRegionAndID(defaultRegion, secretID)
+
+	version, err := secret.NewAPI(meta.ExtractScwClient(m)).GetSecretVersion(&secret.GetSecretVersionRequest{
+		Region:   region,
+		SecretID: id,
+		Revision: revision,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password secret %s revision %s: %w", secretID, revision, err)
+	}
+
+	return version, nil
+}
+
+// accessUserPasswordSecret returns the payload of the given revision of the secret
+func accessUserPasswordSecret(ctx context.Context, m interface{}, defaultRegion scw.Region, secretID string, revision string) (*secret.AccessSecretVersionResponse, error) {
+	region, id := secretRegionAndID(defaultRegion, secretID)
+
+	res, err := secret.NewAPI(meta.ExtractScwClient(m)).AccessSecretVersion(&secret.AccessSecretVersionRequest{
+		Region:   region,
+		SecretID: id,
+		Revision: revision,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to access password secret %s revision %s: %w", secretID, revision, err)
+	}
+
+	return res, nil
+}
+
+// instanceEndpointAddresses returns the host:port address of every endpoint of the instance.
+func instanceEndpointAddresses(endpoints []*rdb.Endpoint) []string {
+	addresses := []string(nil)
+	for _, endpoint := range endpoints {
+		switch {
+		case endpoint.IP != nil:
+			addresses = append(addresses, net.JoinHostPort(endpoint.IP.String(), strconv.Itoa(int(endpoint.Port))))
+		case endpoint.Hostname != nil:
+			addresses = append(addresses, net.JoinHostPort(*endpoint.Hostname, strconv.Itoa(int(endpoint.Port))))
+		}
+	}
+
+	return addresses
+}
@@ -0,0 +1,26 @@
+package rdb
+
+import (
+	"net"
+	"testing"
+
+	rdbSDK "github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstanceEndpointAddresses(t *testing.T) {
+	ip := net.ParseIP("51.159.1.2")
+	ipv6 := net.ParseIP("2001:bc8:1::2")
+
+	addresses := instanceEndpointAddresses([]*rdbSDK.Endpoint{
+		{IP: &ip, Port: 5432},
+		{IP: &ipv6, Port: 5432},
+		{Hostname: scw.StringPtr("rdb.example.com"), Port: 1234},
+		{Port: 5432},
+	})
+
+	// Endpoints without an IP nor a hostname are skipped
+	assert.Equal(t, []string{"51.159.1.2:5432", "[2001:bc8:1::2]:5432", "rdb.example.com:1234"}, addresses)
+	assert.Empty(t, instanceEndpointAddresses(nil))
+}
@@ -8,16 +8,19 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/console"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -89,6 +92,12 @@ func ResourceInstance() *schema.Resource {
 				Computed:    true,
 				Description: "Boolean to store logical backups in the same region as the database instance",
 			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Prevent the database instance from being deleted",
+			},
 			"user_name": {
 				Type:        schema.TypeString,
 				ForceNew:    true,
@@ -309,12 +318,23 @@ func ResourceInstance() *schema.Resource {
 				Optional:    true,
 				Description: "Enable or disable encryption at rest for the database instance",
 			},
+			"wait_for_connectivity": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait at creation until the endpoints of the database instance accept TCP connections",
+			},
 			// Common
 			"region":          regional.Schema(),
 			"organization_id": account.OrganizationIDSchema(),
+			"console_url":     console.URLSchema(),
 			"project_id":      account.ProjectIDSchema(),
 		},
-		CustomizeDiff: cdf.LocalityCheck("private_network.#.pn_id"),
+		CustomizeDiff: customdiff.All(
+			cdf.LocalityCheck("private_network.#.pn_id"),
+			customizeDiffVolumeSizeInGB,
+			customizeDiffPrivateNetworkIPConfig,
+		),
 	}
 }
 
@@ -431,6 +451,18 @@ func ResourceRdbInstanceCreate(ctx context.Context, d *schema.ResourceData, m in
 		}
 	}
 
+	if d.Get("wait_for_connectivity").(bool) {
+		res, err = waitForRDBInstance(ctx, rdbAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = transport.WaitForTCPConnectivity(ctx, instanceEndpointAddresses(res.Endpoints), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return ResourceRdbInstanceRead(ctx, d, m)
 }
 
@@ -489,6 +521,7 @@ func ResourceRdbInstanceRead(ctx context.Context, d *schema.ResourceData, m inte
 	}
 	_ = d.Set("read_replicas", []string{})
 	_ = d.Set("region", string(region))
+	_ = d.Set("console_url", console.URL("rdb/instances", region.String(), res.ID))
 	_ = d.Set("organization_id", res.OrganizationID)
 	_ = d.Set("project_id", res.ProjectID)
 	if res.Encryption != nil {
@@ -872,6 +905,10 @@ func ResourceRdbInstanceDelete(ctx context.Context, d *schema.ResourceData, m in
 		return diag.FromErr(err)
 	}
 
+	if d.Get("deletion_protection").(bool) {
+		return diag.Errorf("database instance %s cannot be deleted while deletion_protection is enabled", d.Id())
+	}
+
 	// We first wait in case the instance is in a transient state
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
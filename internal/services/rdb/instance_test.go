@@ -1,7 +1,9 @@
 package rdb_test
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -11,6 +13,8 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/rdb"
 	rdbchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/rdb/testfuncs"
 	vpcchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc/testfuncs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -1383,6 +1387,76 @@ func TestAccInstance_UpdateEncryptionAtRest(t *testing.T) {
 	})
 }
 
+func TestAccInstance_DeletionProtection(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	latestEngineVersion := rdbchecks.GetLatestEngineVersion(tt, postgreSQLEngineName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      rdbchecks.IsInstanceDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource scaleway_rdb_instance main {
+						name = "test-rdb-deletion-protection"
+						node_type = "db-dev-s"
+						engine = %q
+						is_ha_cluster = false
+						disable_backup = true
+						user_name = "my_initial_user"
+						password = "thiZ_is_v&ry_s3cret"
+						deletion_protection = %t
+					}
+				`, latestEngineVersion, true),
+				Check: resource.ComposeTestCheckFunc(
+					isInstancePresent(tt, "scaleway_rdb_instance.main"),
+					resource.TestCheckResourceAttr("scaleway_rdb_instance.main", "deletion_protection", "true"),
+				),
+			},
+			{
+				Destroy: true,
+				Config: fmt.Sprintf(`
+					resource scaleway_rdb_instance main {
+						name = "test-rdb-deletion-protection"
+						node_type = "db-dev-s"
+						engine = %q
+						is_ha_cluster = false
+						disable_backup = true
+						user_name = "my_initial_user"
+						password = "thiZ_is_v&ry_s3cret"
+						deletion_protection = %t
+					}
+				`, latestEngineVersion, true),
+				ExpectError: regexp.MustCompile("cannot be deleted while deletion_protection is enabled"),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource scaleway_rdb_instance main {
+						name = "test-rdb-deletion-protection"
+						node_type = "db-dev-s"
+						engine = %q
+						is_ha_cluster = false
+						disable_backup = true
+						user_name = "my_initial_user"
+						password = "thiZ_is_v&ry_s3cret"
+						deletion_protection = %t
+					}
+				`, latestEngineVersion, false),
+				Check: resource.ComposeTestCheckFunc(
+					isInstancePresent(tt, "scaleway_rdb_instance.main"),
+					resource.TestCheckResourceAttr("scaleway_rdb_instance.main", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func isInstancePresent(tt *acctest.TestTools, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1406,3 +1480,41 @@ func isInstancePresent(tt *acctest.TestTools, n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestInstance_VolumeSizeInGB(t *testing.T) {
+	r := rdb.ResourceInstance()
+	config := map[string]interface{}{
+		"engine":            "PostgreSQL-15",
+		"node_type":         "db-dev-s",
+		"volume_type":       "sbs_5k",
+		"volume_size_in_gb": 12,
+	}
+
+	diags := r.Validate(terraform.NewResourceConfigRaw(config))
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "expected volume_size_in_gb to be divisible by 5")
+
+	config["volume_size_in_gb"] = 10
+	diags = r.Validate(terraform.NewResourceConfigRaw(config))
+	require.False(t, diags.HasError(), diags)
+
+	// The volume can only grow
+	state := &terraform.InstanceState{ID: "fr-par/11111111-1111-1111-1111-111111111111", Attributes: map[string]string{
+		"id":                "fr-par/11111111-1111-1111-1111-111111111111",
+		"region":            "fr-par",
+		"engine":            "PostgreSQL-15",
+		"node_type":         "db-dev-s",
+		"volume_type":       "sbs_5k",
+		"volume_size_in_gb": "20",
+	}}
+	rawState, err := state.AttrsAsObjectValue(r.CoreConfigSchema().ImpliedType())
+	require.NoError(t, err)
+	state.RawState = rawState
+
+	_, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	require.ErrorContains(t, err, "volume_size_in_gb cannot be decreased (from 20 GB to 10 GB)")
+
+	config["volume_size_in_gb"] = 30
+	_, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+}
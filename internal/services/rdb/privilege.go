@@ -286,7 +286,7 @@ func ResourceRdbPrivilegeDelete(ctx context.Context, d *schema.ResourceData, m i
 	}
 
 	//  wrapper around StateChangeConf that will just retry the database creation
-	err = retry.RetryContext(ctx, defaultInstanceTimeout, func() *retry.RetryError {
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 		// check if user exist on retry
 		listUsers, errUserExist := rdbAPI.ListUsers(&rdb.ListUsersRequest{
 			Region:     region,
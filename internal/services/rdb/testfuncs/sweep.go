@@ -29,6 +29,9 @@ func testSweepInstance(_ string) error {
 		}
 
 		for _, instance := range listInstances.Instances {
+			if !acctest.ShouldSweep("scaleway_rdb_instance", instance.ID, instance.Name, instance.Tags) {
+				continue
+			}
 			_, err := rdbAPI.DeleteInstance(&rdbSDK.DeleteInstanceRequest{
 				Region:     region,
 				InstanceID: instance.ID,
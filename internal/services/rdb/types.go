@@ -104,6 +104,7 @@ func flattenPrivateNetwork(endpoints []*rdb.Endpoint) (interface{}, bool) {
 				"pn_id":       pnRegionalID,
 				"hostname":    types.FlattenStringPtr(endpoint.Hostname),
 				"enable_ipam": enableIpam,
+				"zone":        pn.Zone.String(),
 			})
 			return pnI, true
 		}
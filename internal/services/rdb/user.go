@@ -3,15 +3,18 @@ package rdb
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
@@ -51,10 +54,30 @@ func ResourceUser() *schema.Resource {
 				ForceNew:    true,
 			},
 			"password": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				Description: "Database user password",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				Description:  "Database user password",
+				ExactlyOneOf: []string{"password", "password_secret_id"},
+			},
+			"password_secret_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The ID of the Secret Manager secret holding the database user password",
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: dsf.Locality,
+			},
+			"password_secret_revision": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "latest_enabled",
+				Description:  "The revision of the secret to use as password, a number, latest or latest_enabled",
+				RequiredWith: []string{"password_secret_id"},
+			},
+			"password_secret_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The revision of the secret currently used as password",
 			},
 			"is_admin": {
 				Type:        schema.TypeBool,
@@ -64,7 +87,10 @@ func ResourceUser() *schema.Resource {
 			// Common
 			"region": regional.Schema(),
 		},
-		CustomizeDiff: cdf.LocalityCheck("instance_id"),
+		CustomizeDiff: customdiff.All(
+			cdf.LocalityCheck("instance_id"),
+			customizeDiffUserPasswordSecretVersion,
+		),
 	}
 }
 
@@ -82,11 +108,16 @@ func ResourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 		return diag.FromErr(err)
 	}
 
+	password, err := userPassword(ctx, d, m, region)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createReq := &rdb.CreateUserRequest{
 		Region:     region,
 		InstanceID: ins.ID,
 		Name:       d.Get("name").(string),
-		Password:   d.Get("password").(string),
+		Password:   password,
 		IsAdmin:    d.Get("is_admin").(bool),
 	}
 
@@ -181,8 +212,12 @@ func ResourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 		Name:       userName,
 	}
 
-	if d.HasChange("password") {
-		req.Password = types.ExpandStringPtr(d.Get("password"))
+	if d.HasChanges("password", "password_secret_id", "password_secret_revision", "password_secret_version") {
+		password, err := userPassword(ctx, d, m, region)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.Password = types.ExpandStringPtr(password)
 	}
 	if d.HasChange("is_admin") {
 		req.IsAdmin = scw.BoolPtr(d.Get("is_admin").(bool))
@@ -251,3 +286,50 @@ func ResourceUserParseID(resourceID string) (region scw.Region, instanceID strin
 	}
 	return scw.Region(idParts[0]), idParts[1], idParts[2], nil
 }
+
+// userPassword returns the password of the user, read from Secret Manager if password_secret_id is set.
+// The secret revision planned in password_secret_version is used, so the password matches the plan.
+func userPassword(ctx context.Context, d *schema.ResourceData, m interface{}, region scw.Region) (string, error) {
+	secretID, isSecret := d.GetOk("password_secret_id")
+	if !isSecret {
+		return d.Get("password").(string), nil
+	}
+
+	revision := d.Get("password_secret_revision").(string)
+	if version, ok := d.GetOk("password_secret_version"); ok {
+		revision = strconv.Itoa(version.(int))
+	}
+
+	res, err := accessUserPasswordSecret(ctx, m, region, secretID.(string), revision)
+	if err != nil {
+		return "", err
+	}
+
+	_ = d.Set("password_secret_version", int(res.Revision))
+
+	return string(res.Data), nil
+}
+
+// customizeDiffUserPasswordSecretVersion plans a password update when the secret revision holding it changed.
+func customizeDiffUserPasswordSecretVersion(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	secretID, isSecret := diff.GetOk("password_secret_id")
+	if !isSecret || !diff.NewValueKnown("password_secret_id") || !diff.NewValueKnown("password_secret_revision") {
+		return nil
+	}
+
+	region, _, err := regional.ParseID(diff.Get("instance_id").(string))
+	if err != nil {
+		return nil
+	}
+
+	version, err := getUserPasswordSecretVersion(ctx, m, region, secretID.(string), diff.Get("password_secret_revision").(string))
+	if err != nil {
+		return err
+	}
+
+	if int(version.Revision) != diff.Get("password_secret_version").(int) {
+		return diff.SetNew("password_secret_version", int(version.Revision))
+	}
+
+	return nil
+}
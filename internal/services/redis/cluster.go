@@ -19,6 +19,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -193,6 +194,12 @@ func ResourceCluster() *schema.Resource {
 					},
 				},
 			},
+			"wait_for_connectivity": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait at creation until the endpoints of the Redis cluster accept TCP connections",
+			},
 			"certificate": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -289,11 +296,18 @@ func ResourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	d.SetId(zonal.NewIDString(zone, res.ID))
 
-	_, err = waitForCluster(ctx, redisAPI, zone, res.ID, d.Timeout(schema.TimeoutCreate))
+	cluster, err := waitForCluster(ctx, redisAPI, zone, res.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if d.Get("wait_for_connectivity").(bool) {
+		err = transport.WaitForTCPConnectivity(ctx, clusterEndpointAddresses(cluster.Endpoints), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return ResourceClusterRead(ctx, d, m)
 }
 
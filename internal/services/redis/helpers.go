@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net"
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -81,3 +83,15 @@ func privateNetworkSetHash(v interface{}) int {
 
 	return types.StringHashcode(buf.String())
 }
+
+// clusterEndpointAddresses returns the host:port address of every IP of the cluster endpoints.
+func clusterEndpointAddresses(endpoints []*redis.Endpoint) []string {
+	addresses := []string(nil)
+	for _, endpoint := range endpoints {
+		for _, ip := range endpoint.IPs {
+			addresses = append(addresses, net.JoinHostPort(ip.String(), strconv.Itoa(int(endpoint.Port))))
+		}
+	}
+
+	return addresses
+}
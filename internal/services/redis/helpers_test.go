@@ -0,0 +1,20 @@
+package redis
+
+import (
+	"net"
+	"testing"
+
+	redisSDK "github.com/scaleway/scaleway-sdk-go/api/redis/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterEndpointAddresses(t *testing.T) {
+	addresses := clusterEndpointAddresses([]*redisSDK.Endpoint{
+		{IPs: []net.IP{net.ParseIP("51.159.1.2"), net.ParseIP("51.159.1.3")}, Port: 6379},
+		{IPs: []net.IP{net.ParseIP("2001:bc8:1::2")}, Port: 6380},
+		{Port: 6379},
+	})
+
+	assert.Equal(t, []string{"51.159.1.2:6379", "51.159.1.3:6379", "[2001:bc8:1::2]:6380"}, addresses)
+	assert.Empty(t, clusterEndpointAddresses(nil))
+}
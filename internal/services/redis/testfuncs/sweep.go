@@ -29,6 +29,9 @@ func testSweepRedisCluster(_ string) error {
 		}
 
 		for _, cluster := range listClusters.Clusters {
+			if !acctest.ShouldSweep("scaleway_redis_cluster", cluster.ID, cluster.Name, cluster.Tags) {
+				continue
+			}
 			_, err := redisAPI.DeleteCluster(&redisSDK.DeleteClusterRequest{
 				Zone:      zone,
 				ClusterID: cluster.ID,
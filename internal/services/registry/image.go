@@ -0,0 +1,263 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/registry/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func ResourceImage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceImageCreate,
+		ReadContext:   ResourceImageRead,
+		UpdateContext: ResourceImageUpdate,
+		DeleteContext: ResourceImageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"namespace_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The ID of the namespace the image was pushed to",
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: dsf.Locality,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the image",
+			},
+			"visibility": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  registry.ImageVisibilityInherit.String(),
+				ValidateFunc: validation.StringInSlice([]string{
+					registry.ImageVisibilityInherit.String(),
+					registry.ImageVisibilityPublic.String(),
+					registry.ImageVisibilityPrivate.String(),
+				}, false),
+				Description: "The visibility of the image, inherit uses the visibility of the namespace",
+			},
+			"delete_tags": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "The tags of the image to delete",
+			},
+			"tags": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed:    true,
+				Description: "The tags of the image",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the image in bytes",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the image",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time of image creation",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time of last update",
+			},
+			"region": regional.Schema(),
+		},
+	}
+}
+
+func ResourceImageCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, err := NewAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespaceID := locality.ExpandID(d.Get("namespace_id"))
+	imageName := d.Get("name").(string)
+
+	res, err := api.ListImages(&registry.ListImagesRequest{
+		Region:      region,
+		NamespaceID: types.ExpandStringPtr(namespaceID),
+		Name:        types.ExpandStringPtr(imageName),
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	image, err := datasource.FindExact(
+		res.Images,
+		func(s *registry.Image) bool { return s.Name == imageName },
+		imageName,
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("image %s must be pushed to namespace %s before being managed: %w", imageName, namespaceID, err))
+	}
+
+	d.SetId(regional.NewIDString(region, image.ID))
+
+	visibility := registry.ImageVisibility(d.Get("visibility").(string))
+	if image.Visibility != visibility {
+		_, err = api.UpdateImage(&registry.UpdateImageRequest{
+			Region:     region,
+			ImageID:    image.ID,
+			Visibility: visibility,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = deleteImageTags(ctx, api, region, image.ID, types.ExpandStrings(d.Get("delete_tags").(*schema.Set).List()))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ResourceImageRead(ctx, d, m)
+}
+
+func ResourceImageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	image, err := api.GetImage(&registry.GetImageRequest{
+		Region:  region,
+		ImageID: id,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if httperrors.Is404(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// Tags pushed again after their deletion are dropped from delete_tags to plan their deletion again.
+	imageTags := make(map[string]struct{}, len(image.Tags))
+	for _, tag := range image.Tags {
+		imageTags[tag] = struct{}{}
+	}
+	deletedTags := []string(nil)
+	for _, tag := range types.ExpandStrings(d.Get("delete_tags").(*schema.Set).List()) {
+		if _, pushed := imageTags[tag]; !pushed {
+			deletedTags = append(deletedTags, tag)
+		}
+	}
+
+	_ = d.Set("namespace_id", regional.NewIDString(region, image.NamespaceID))
+	_ = d.Set("name", image.Name)
+	_ = d.Set("visibility", image.Visibility.String())
+	_ = d.Set("delete_tags", deletedTags)
+	_ = d.Set("tags", image.Tags)
+	_ = d.Set("size", int(image.Size))
+	_ = d.Set("status", image.Status.String())
+	_ = d.Set("created_at", types.FlattenTime(image.CreatedAt))
+	_ = d.Set("updated_at", types.FlattenTime(image.UpdatedAt))
+	_ = d.Set("region", region)
+
+	return nil
+}
+
+func ResourceImageUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("visibility") {
+		_, err = api.UpdateImage(&registry.UpdateImageRequest{
+			Region:     region,
+			ImageID:    id,
+			Visibility: registry.ImageVisibility(d.Get("visibility").(string)),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("delete_tags") {
+		err = deleteImageTags(ctx, api, region, id, types.ExpandStrings(d.Get("delete_tags").(*schema.Set).List()))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ResourceImageRead(ctx, d, m)
+}
+
+func ResourceImageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = api.DeleteImage(&registry.DeleteImageRequest{
+		Region:  region,
+		ImageID: id,
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// deleteImageTags deletes the tags of an image matching the given names, tags already deleted are ignored.
+func deleteImageTags(ctx context.Context, api *registry.API, region scw.Region, imageID string, names []string) error {
+	for _, name := range names {
+		res, err := api.ListTags(&registry.ListTagsRequest{
+			Region:  region,
+			ImageID: imageID,
+			Name:    types.ExpandStringPtr(name),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+
+		for _, tag := range res.Tags {
+			if tag.Name != name {
+				continue
+			}
+
+			_, err = api.DeleteTag(&registry.DeleteTagRequest{
+				Region: region,
+				TagID:  tag.ID,
+			}, scw.WithContext(ctx))
+			if err != nil && !httperrors.Is404(err) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
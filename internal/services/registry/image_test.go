@@ -0,0 +1,90 @@
+package registry_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccImage_Basic(t *testing.T) {
+	t.Skip("It is difficult to test this resource as we cannot push registry images with Terraform, the image ubuntu must be pushed with the tags latest, foo and bar first and it is deleted by the test.")
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	ubuntuImageID := "4b5a47c0-6fbf-4388-8783-c07c28d3c2eb"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "scaleway_registry_image" "ubuntu" {
+						image_id = "` + ubuntuImageID + `"
+					}
+
+					resource "scaleway_registry_image" "ubuntu" {
+						namespace_id = data.scaleway_registry_image.ubuntu.namespace_id
+						name         = data.scaleway_registry_image.ubuntu.name
+						visibility   = "private"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					isImagePresent(tt, "scaleway_registry_image.ubuntu"),
+					resource.TestCheckResourceAttr("scaleway_registry_image.ubuntu", "id", "fr-par/"+ubuntuImageID),
+					resource.TestCheckResourceAttr("scaleway_registry_image.ubuntu", "visibility", "private"),
+					resource.TestCheckResourceAttr("scaleway_registry_image.ubuntu", "tags.#", "3"),
+				),
+			},
+			{
+				Config: `
+					data "scaleway_registry_image" "ubuntu" {
+						image_id = "` + ubuntuImageID + `"
+					}
+
+					resource "scaleway_registry_image" "ubuntu" {
+						namespace_id = data.scaleway_registry_image.ubuntu.namespace_id
+						name         = data.scaleway_registry_image.ubuntu.name
+						visibility   = "private"
+						delete_tags  = ["bar"]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					isImagePresent(tt, "scaleway_registry_image.ubuntu"),
+					resource.TestCheckResourceAttr("scaleway_registry_image.ubuntu", "delete_tags.#", "1"),
+					resource.TestCheckResourceAttr("scaleway_registry_image.ubuntu", "tags.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImage_NotPushed(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      isNamespaceDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_registry_namespace" "main" {
+						name = "test-cr-image-not-pushed"
+					}
+
+					resource "scaleway_registry_image" "app" {
+						namespace_id = scaleway_registry_namespace.main.id
+						name         = "app"
+					}
+				`,
+				ExpectError: regexp.MustCompile("image app must be pushed to namespace .* before being managed"),
+			},
+		},
+	})
+}
@@ -28,6 +28,9 @@ func testSweepNamespace(_ string) error {
 		}
 
 		for _, ns := range listNamespaces.Namespaces {
+			if !acctest.ShouldSweep("scaleway_registry_namespace", ns.ID, ns.Name, nil) {
+				continue
+			}
 			_, err := registryAPI.DeleteNamespace(&registrySDK.DeleteNamespaceRequest{
 				NamespaceID: ns.ID,
 				Region:      region,
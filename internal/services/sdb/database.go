@@ -2,6 +2,7 @@ package sdb
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -54,12 +55,38 @@ func ResourceDatabase() *schema.Resource {
 				Computed:    true,
 				Description: "endpoint of the database",
 			},
-			"region":     regional.Schema(),
-			"project_id": account.ProjectIDSchema(),
+			"engine_major_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The major version of the PostgreSQL engine of the database",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the creation of the database",
+			},
+			"region":          regional.Schema(),
+			"project_id":      account.ProjectIDSchema(),
+			"organization_id": account.OrganizationIDSchema(),
 		},
+		CustomizeDiff: customizeDiffDatabaseCPU,
 	}
 }
 
+func customizeDiffDatabaseCPU(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("min_cpu") || !diff.NewValueKnown("max_cpu") {
+		return nil
+	}
+
+	minCPU := diff.Get("min_cpu").(int)
+	maxCPU := diff.Get("max_cpu").(int)
+	if minCPU > maxCPU {
+		return fmt.Errorf("min_cpu (%d) must be lower than or equal to max_cpu (%d)", minCPU, maxCPU)
+	}
+
+	return nil
+}
+
 func ResourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
@@ -107,8 +134,11 @@ func ResourceDatabaseRead(ctx context.Context, d *schema.ResourceData, m interfa
 	_ = d.Set("max_cpu", int(database.CPUMax))
 	_ = d.Set("min_cpu", int(database.CPUMin))
 	_ = d.Set("endpoint", database.Endpoint)
+	_ = d.Set("engine_major_version", int(database.EngineMajorVersion))
+	_ = d.Set("created_at", types.FlattenTime(database.CreatedAt))
 	_ = d.Set("region", database.Region)
 	_ = d.Set("project_id", database.ProjectID)
+	_ = d.Set("organization_id", database.OrganizationID)
 
 	return nil
 }
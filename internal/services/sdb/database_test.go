@@ -2,6 +2,7 @@ package sdb_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -136,3 +137,47 @@ func testAccCheckServerlessSQLDBDatabaseDestroy(tt *acctest.TestTools) resource.
 		return nil
 	}
 }
+
+func TestAccServerlessSQLDBDatabase_EngineVersionAndCPURange(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckServerlessSQLDBDatabaseDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_sdb_sql_database main {
+						name = "test-sdb-sql-database-cpu-range"
+						min_cpu = 4
+						max_cpu = 2
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta("min_cpu (4) must be lower than or equal to max_cpu (2)")),
+			},
+			{
+				Config: `
+					resource scaleway_sdb_sql_database main {
+						name = "test-sdb-sql-database-cpu-range"
+						min_cpu = 2
+						max_cpu = 2
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessSQLDBDatabaseExists(tt, "scaleway_sdb_sql_database.main"),
+					resource.TestCheckResourceAttr("scaleway_sdb_sql_database.main", "min_cpu", "2"),
+					resource.TestCheckResourceAttr("scaleway_sdb_sql_database.main", "max_cpu", "2"),
+					resource.TestCheckResourceAttrSet("scaleway_sdb_sql_database.main", "engine_major_version"),
+					resource.TestCheckResourceAttrSet("scaleway_sdb_sql_database.main", "created_at"),
+					resource.TestCheckResourceAttrSet("scaleway_sdb_sql_database.main", "organization_id"),
+				),
+			},
+		},
+	})
+}
@@ -30,6 +30,9 @@ func testSweepServerlessSQLDBDatabase(_ string) error {
 		}
 
 		for _, database := range listServerlessSQLDBDatabases.Databases {
+			if !acctest.ShouldSweep("scaleway_sdb_sql_database", database.ID, database.Name, nil) {
+				continue
+			}
 			_, err := sdbAPI.DeleteDatabase(&sdbSDK.DeleteDatabaseRequest{
 				DatabaseID: database.ID,
 				Region:     region,
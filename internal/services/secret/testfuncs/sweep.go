@@ -29,6 +29,9 @@ func testSweepSecret(_ string) error {
 		}
 
 		for _, se := range listSecrets.Secrets {
+			if !acctest.ShouldSweep("scaleway_secret", se.ID, se.Name, se.Tags) {
+				continue
+			}
 			err := secretAPI.DeleteSecret(&secretSDK.DeleteSecretRequest{
 				SecretID: se.ID,
 				Region:   region,
@@ -42,6 +42,12 @@ func DataSourceVersion() *schema.Resource {
 		Sensitive:   true,
 		Description: "The payload of the secret version",
 	}
+	dsSchema["fail_if_disabled"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Return an error if the secret version is disabled",
+	}
 	dsSchema["organization_id"] = account.OrganizationIDOptionalSchema()
 	dsSchema["project_id"] = &schema.Schema{
 		Type:             schema.TypeString,
@@ -129,5 +135,9 @@ func datasourceSchemaFromResourceVersionSchema(ctx context.Context, d *schema.Re
 		return diag.Errorf("secret version (%s) not found", secretVersionIDStr)
 	}
 
+	if d.Get("fail_if_disabled").(bool) && d.Get("status").(string) == secret.SecretVersionStatusDisabled.String() {
+		return diag.Errorf("secret version (%s) is disabled", secretVersionIDStr)
+	}
+
 	return nil
 }
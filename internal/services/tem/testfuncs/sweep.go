@@ -32,6 +32,9 @@ func testSweepDomain(_ string) error {
 				logging.L.Debugf("sweeper: skipping deletion of domain %s", ns.Name)
 				continue
 			}
+			if !acctest.ShouldSweep("scaleway_tem_domain", ns.ID, ns.Name, nil) {
+				continue
+			}
 			_, err := temAPI.RevokeDomain(&temSDK.RevokeDomainRequest{
 				DomainID: ns.ID,
 				Region:   region,
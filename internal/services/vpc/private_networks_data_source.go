@@ -0,0 +1,161 @@
+package vpc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/vpc/v2"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func DataSourcePrivateNetworks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourcePrivateNetworksRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Private networks with a name like it are listed.",
+			},
+			"tags": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Private networks with these exact tags are listed.",
+			},
+			"vpc_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Private networks of this VPC are listed.",
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: dsf.Locality,
+			},
+			"private_networks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"name": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"vpc_id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"tags": {
+							Computed: true,
+							Type:     schema.TypeList,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"ipv4_subnet": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"ipv6_subnets": {
+							Computed: true,
+							Type:     schema.TypeList,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"dhcp_enabled": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+						"created_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"updated_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"region":          regional.Schema(),
+						"organization_id": account.OrganizationIDSchema(),
+						"project_id":      account.ProjectIDSchema(),
+					},
+				},
+			},
+			"region":          regional.Schema(),
+			"organization_id": account.OrganizationIDSchema(),
+			"project_id":      account.ProjectIDSchema(),
+		},
+	}
+}
+
+func DataSourcePrivateNetworksRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	vpcAPI, region, err := vpcAPIWithRegion(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &vpc.ListPrivateNetworksRequest{
+		Region:    region,
+		Tags:      types.ExpandStrings(d.Get("tags")),
+		Name:      types.ExpandStringPtr(d.Get("name")),
+		ProjectID: types.ExpandStringPtr(d.Get("project_id")),
+	}
+	if vpcID, ok := d.GetOk("vpc_id"); ok {
+		req.VpcID = types.ExpandStringPtr(locality.ExpandID(vpcID))
+	}
+
+	res, err := vpcAPI.ListPrivateNetworks(req, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	privateNetworks := []interface{}(nil)
+	for _, privateNetwork := range res.PrivateNetworks {
+		rawPrivateNetwork := make(map[string]interface{})
+		rawPrivateNetwork["id"] = regional.NewIDString(region, privateNetwork.ID)
+		rawPrivateNetwork["name"] = privateNetwork.Name
+		rawPrivateNetwork["vpc_id"] = regional.NewIDString(region, privateNetwork.VpcID)
+		if len(privateNetwork.Tags) > 0 {
+			rawPrivateNetwork["tags"] = privateNetwork.Tags
+		}
+
+		ipv6Subnets := []string(nil)
+		for _, subnet := range privateNetwork.Subnets {
+			cidr, err := types.FlattenIPNet(subnet.Subnet)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if subnet.Subnet.IP.To4() != nil {
+				rawPrivateNetwork["ipv4_subnet"] = cidr
+			} else {
+				ipv6Subnets = append(ipv6Subnets, cidr)
+			}
+		}
+		rawPrivateNetwork["ipv6_subnets"] = ipv6Subnets
+
+		rawPrivateNetwork["dhcp_enabled"] = privateNetwork.DHCPEnabled
+		rawPrivateNetwork["created_at"] = types.FlattenTime(privateNetwork.CreatedAt)
+		rawPrivateNetwork["updated_at"] = types.FlattenTime(privateNetwork.UpdatedAt)
+		rawPrivateNetwork["region"] = region.String()
+		rawPrivateNetwork["organization_id"] = privateNetwork.OrganizationID
+		rawPrivateNetwork["project_id"] = privateNetwork.ProjectID
+
+		privateNetworks = append(privateNetworks, rawPrivateNetwork)
+	}
+
+	d.SetId(region.String())
+	_ = d.Set("private_networks", privateNetworks)
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package vpc_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	vpcchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc/testfuncs"
+)
+
+func TestAccDataSourcePrivateNetworks_Basic(t *testing.T) {
+	if !*acctest.UpdateCassettes {
+		t.Skip("Cassette not recorded yet, run with TF_UPDATE_CASSETTES=true to record it")
+	}
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      vpcchecks.CheckPrivateNetworkDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_vpc vpc01 {
+						name = "tf-pns-datasource"
+					}
+
+					resource scaleway_vpc_private_network pn01 {
+						name   = "tf-pns-datasource0"
+						vpc_id = scaleway_vpc.vpc01.id
+						tags   = [ "terraform-test", "data_scaleway_vpc_private_networks", "front" ]
+					}
+
+					resource scaleway_vpc_private_network pn02 {
+						name   = "tf-pns-datasource1"
+						vpc_id = scaleway_vpc.vpc01.id
+						tags   = [ "terraform-test", "data_scaleway_vpc_private_networks", "back" ]
+					}`,
+			},
+			{
+				Config: `
+					resource scaleway_vpc vpc01 {
+						name = "tf-pns-datasource"
+					}
+
+					resource scaleway_vpc_private_network pn01 {
+						name   = "tf-pns-datasource0"
+						vpc_id = scaleway_vpc.vpc01.id
+						tags   = [ "terraform-test", "data_scaleway_vpc_private_networks", "front" ]
+					}
+
+					resource scaleway_vpc_private_network pn02 {
+						name   = "tf-pns-datasource1"
+						vpc_id = scaleway_vpc.vpc01.id
+						tags   = [ "terraform-test", "data_scaleway_vpc_private_networks", "back" ]
+					}
+
+					data scaleway_vpc_private_networks by_vpc {
+						vpc_id = scaleway_vpc.vpc01.id
+					}
+
+					data scaleway_vpc_private_networks by_name {
+						name   = "tf-pns-datasource"
+						vpc_id = scaleway_vpc.vpc01.id
+					}
+
+					data scaleway_vpc_private_networks by_tag {
+						tags   = [ "data_scaleway_vpc_private_networks", "front" ]
+						vpc_id = scaleway_vpc.vpc01.id
+					}
+
+					data scaleway_vpc_private_networks by_name_other_region {
+						name   = "tf-pns-datasource"
+						region = "nl-ams"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_vpc_private_networks.by_vpc", "private_networks.#", "2"),
+					resource.TestCheckResourceAttr("data.scaleway_vpc_private_networks.by_name", "private_networks.#", "2"),
+
+					resource.TestCheckResourceAttr("data.scaleway_vpc_private_networks.by_tag", "private_networks.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_vpc_private_networks.by_tag", "private_networks.0.id", "scaleway_vpc_private_network.pn01", "id"),
+					resource.TestCheckResourceAttrPair("data.scaleway_vpc_private_networks.by_tag", "private_networks.0.vpc_id", "scaleway_vpc.vpc01", "id"),
+					resource.TestCheckResourceAttrPair("data.scaleway_vpc_private_networks.by_tag", "private_networks.0.ipv4_subnet", "scaleway_vpc_private_network.pn01", "ipv4_subnet.0.subnet"),
+					resource.TestCheckResourceAttrSet("data.scaleway_vpc_private_networks.by_tag", "private_networks.0.ipv6_subnets.0"),
+
+					resource.TestCheckNoResourceAttr("data.scaleway_vpc_private_networks.by_name_other_region", "private_networks.0.id"),
+				),
+			},
+		},
+	})
+}
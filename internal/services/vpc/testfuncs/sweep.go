@@ -41,6 +41,9 @@ func testSweepVPC(_ string) error {
 		}
 
 		for _, v := range listVPCs.Vpcs {
+			if !acctest.ShouldSweep("scaleway_vpc", v.ID, v.Name, v.Tags) {
+				continue
+			}
 			if v.IsDefault {
 				continue
 			}
@@ -73,6 +76,9 @@ func testSweepVPCPrivateNetwork(_ string) error {
 		}
 
 		for _, pn := range listPNResponse.PrivateNetworks {
+			if !acctest.ShouldSweep("scaleway_vpc_private_network", pn.ID, pn.Name, pn.Tags) {
+				continue
+			}
 			err := vpcAPI.DeletePrivateNetwork(&vpcSDK.DeletePrivateNetworkRequest{
 				Region:           region,
 				PrivateNetworkID: pn.ID,
@@ -107,6 +113,9 @@ func testSweepVPCRoute(_ string) error {
 
 		for _, routeWithNexthop := range listRoutesResponse.Routes {
 			if routeWithNexthop.Route != nil {
+				if !acctest.ShouldSweep("scaleway_vpc_route", routeWithNexthop.Route.ID, routeWithNexthop.Route.Description, routeWithNexthop.Route.Tags) {
+					continue
+				}
 				err := vpcAPI.DeleteRoute(&vpcSDK.DeleteRouteRequest{
 					Region:  region,
 					RouteID: routeWithNexthop.Route.ID,
@@ -61,6 +61,11 @@ func ResourcePublicGateway() *schema.Resource {
 				Description:      "attach an existing IP to the gateway",
 				DiffSuppressFunc: dsf.Locality,
 			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The public IP address of the gateway",
+			},
 			"tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -181,6 +186,7 @@ func ResourceVPCPublicGatewayRead(ctx context.Context, d *schema.ResourceData, m
 	_ = d.Set("tags", gateway.Tags)
 	_ = d.Set("upstream_dns_servers", gateway.UpstreamDNSServers)
 	_ = d.Set("ip_id", zonal.NewID(gateway.Zone, gateway.IP.ID).String())
+	_ = d.Set("ip_address", gateway.IP.Address.String())
 	_ = d.Set("bastion_enabled", gateway.BastionEnabled)
 	_ = d.Set("bastion_port", int(gateway.BastionPort))
 	_ = d.Set("enable_smtp", gateway.SMTPEnabled)
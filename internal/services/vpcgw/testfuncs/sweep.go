@@ -42,6 +42,9 @@ func testSweepVPCPublicGateway(_ string) error {
 		}
 
 		for _, gateway := range listGatewayResponse.Gateways {
+			if !acctest.ShouldSweep("scaleway_vpc_public_gateway", gateway.ID, gateway.Name, gateway.Tags) {
+				continue
+			}
 			err := api.DeleteGateway(&vpcgwSDK.DeleteGatewayRequest{
 				Zone:      zone,
 				GatewayID: gateway.ID,
@@ -67,6 +70,9 @@ func testSweepVPCGatewayNetwork(_ string) error {
 		}
 
 		for _, gn := range listPNResponse.GatewayNetworks {
+			if !acctest.ShouldSweep("scaleway_vpc_gateway_network", gn.ID, "", nil) {
+				continue
+			}
 			err := api.DeleteGatewayNetwork(&vpcgwSDK.DeleteGatewayNetworkRequest{
 				GatewayNetworkID: gn.GatewayID,
 				Zone:             zone,
@@ -94,6 +100,9 @@ func testSweepVPCPublicGatewayIP(_ string) error {
 		}
 
 		for _, ip := range listIPResponse.IPs {
+			if !acctest.ShouldSweep("scaleway_vpc_public_gateway_ip", ip.ID, ip.Address.String(), ip.Tags) {
+				continue
+			}
 			err := api.DeleteIP(&vpcgwSDK.DeleteIPRequest{
 				Zone: zone,
 				IPID: ip.ID,
@@ -119,6 +128,9 @@ func testSweepVPCPublicGatewayDHCP(_ string) error {
 		}
 
 		for _, dhcp := range listDHCPsResponse.Dhcps {
+			if !acctest.ShouldSweep("scaleway_vpc_public_gateway_dhcp", dhcp.ID, "", nil) {
+				continue
+			}
 			err := api.DeleteDHCP(&vpcgwSDK.DeleteDHCPRequest{
 				Zone:   zone,
 				DHCPID: dhcp.ID,
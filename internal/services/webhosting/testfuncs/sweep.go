@@ -29,6 +29,9 @@ func testSweepWebhosting(_ string) error {
 		}
 
 		for _, hosting := range listHostings.Hostings {
+			if !acctest.ShouldSweep("scaleway_webhosting", hosting.ID, hosting.Domain, hosting.Tags) {
+				continue
+			}
 			_, err := webhsotingAPI.DeleteHosting(&webhostingSDK.DeleteHostingRequest{
 				HostingID: hosting.ID,
 				Region:    region,
@@ -83,7 +83,8 @@ func (c *RetryableTransport) RoundTrip(r *http.Request) (*http.Response, error)
 		}
 		body = bytes.NewReader(bs)
 	}
-	req, err := retryablehttp.NewRequest(r.Method, r.URL.String(), body)
+	// The context carries the deadline of the resource operation, it must be kept to cancel hung requests.
+	req, err := retryablehttp.NewRequestWithContext(r.Context(), r.Method, r.URL.String(), body)
 	if err != nil {
 		return nil, err
 	}
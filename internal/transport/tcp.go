@@ -0,0 +1,45 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	tcpConnectivityRetryInterval = 5 * time.Second
+	tcpDialTimeout               = 10 * time.Second
+)
+
+// WaitForTCPConnectivity waits until a TCP connection can be opened to every address, given as host:port.
+// It is used after a managed database reports ready, as its endpoints may still refuse connections for a while.
+func WaitForTCPConnectivity(ctx context.Context, addresses []string, timeout time.Duration) error {
+	retryInterval := tcpConnectivityRetryInterval
+	if DefaultWaitRetryInterval != nil {
+		retryInterval = *DefaultWaitRetryInterval
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	dialer := &net.Dialer{Timeout: tcpDialTimeout}
+
+	for _, address := range addresses {
+		for {
+			conn, err := dialer.DialContext(ctx, "tcp", address)
+			if err == nil {
+				_ = conn.Close()
+				break
+			}
+
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("endpoint %s did not accept TCP connections within %s: %w", address, timeout, err)
+			case <-time.After(retryInterval):
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package transport
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setWaitRetryInterval shortens the retry interval for the duration of the test.
+func setWaitRetryInterval(t *testing.T, interval time.Duration) {
+	t.Helper()
+
+	previous := DefaultWaitRetryInterval
+	DefaultWaitRetryInterval = &interval
+	t.Cleanup(func() {
+		DefaultWaitRetryInterval = previous
+	})
+}
+
+// listen accepts and closes TCP connections on a local port until the test ends.
+func listen(t *testing.T, address string) net.Listener {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", address)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.Close()
+		}
+	}()
+
+	return listener
+}
+
+// closedAddress returns a local address nothing listens on.
+func closedAddress(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	return address
+}
+
+func TestWaitForTCPConnectivity(t *testing.T) {
+	setWaitRetryInterval(t, 10*time.Millisecond)
+
+	first := listen(t, "127.0.0.1:0")
+	second := listen(t, "127.0.0.1:0")
+
+	err := WaitForTCPConnectivity(context.Background(), []string{first.Addr().String(), second.Addr().String()}, time.Second)
+	require.NoError(t, err)
+}
+
+func TestWaitForTCPConnectivity_EndpointListeningLater(t *testing.T) {
+	setWaitRetryInterval(t, 10*time.Millisecond)
+
+	address := closedAddress(t)
+	listenErr := make(chan error, 1)
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		listener, err := net.Listen("tcp", address)
+		if err != nil {
+			listenErr <- err
+			return
+		}
+		defer listener.Close()
+
+		conn, err := listener.Accept()
+		if err == nil {
+			_ = conn.Close()
+		}
+		listenErr <- err
+	}()
+
+	err := WaitForTCPConnectivity(context.Background(), []string{address}, 5*time.Second)
+	require.NoError(t, err)
+	require.NoError(t, <-listenErr)
+}
+
+func TestWaitForTCPConnectivity_Timeout(t *testing.T) {
+	setWaitRetryInterval(t, 10*time.Millisecond)
+
+	opened := listen(t, "127.0.0.1:0")
+	address := closedAddress(t)
+
+	start := time.Now()
+	err := WaitForTCPConnectivity(context.Background(), []string{opened.Addr().String(), address}, 200*time.Millisecond)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "endpoint "+address+" did not accept TCP connections within 200ms")
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
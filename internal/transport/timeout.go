@@ -0,0 +1,58 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// NewTimeoutTransport creates a http transport bounding each request, retries included, with a context deadline.
+// It is only used by the Scaleway SDK client, as uploads to Object Storage or functions may legitimately take longer.
+func NewTimeoutTransport(defaultTransport http.RoundTripper, timeout time.Duration) http.RoundTripper {
+	if defaultTransport == nil {
+		defaultTransport = http.DefaultTransport
+	}
+
+	return &TimeoutTransport{
+		transport: defaultTransport,
+		timeout:   timeout,
+	}
+}
+
+// TimeoutTransport cancels requests that did not answer within its timeout
+type TimeoutTransport struct {
+	transport http.RoundTripper
+	timeout   time.Duration
+}
+
+// RoundTrip performs the request with a deadline, the deadline is released once the response body is closed.
+func (t *TimeoutTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(r.Context(), t.timeout)
+
+	resp, err := t.transport.RoundTrip(r.WithContext(ctx))
+	if err != nil {
+		timedOut := errors.Is(ctx.Err(), context.DeadlineExceeded) && r.Context().Err() == nil
+		cancel()
+		if timedOut {
+			return nil, fmt.Errorf("%s %s did not answer within the API timeout of %s, the API may be degraded or the timeout too short: %w", r.Method, r.URL.Path, t.timeout, err)
+		}
+		return nil, err
+	}
+
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
@@ -1,9 +1,13 @@
 package verify
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/scaleway-sdk-go/validation"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 )
@@ -11,9 +15,71 @@ import (
 // IsUUIDorUUIDWithLocality validates the schema is a UUID or the combination of a locality and a UUID
 // e.g. "6ba7b810-9dad-11d1-80b4-00c04fd430c8" or "fr-par-1/6ba7b810-9dad-11d1-80b4-00c04fd430c8".
 func IsUUIDorUUIDWithLocality() schema.SchemaValidateDiagFunc {
+	return isUUIDorUUIDWithLocality(isZoneOrRegion, "zone or region", "fr-par-1/")
+}
+
+// IsUUIDorUUIDWithZone validates the schema is a UUID or the combination of a zone and a UUID
+// e.g. "6ba7b810-9dad-11d1-80b4-00c04fd430c8" or "fr-par-1/6ba7b810-9dad-11d1-80b4-00c04fd430c8".
+func IsUUIDorUUIDWithZone() schema.SchemaValidateDiagFunc {
+	return isUUIDorUUIDWithLocality(isZone, "zone", "fr-par-1/")
+}
+
+// IsUUIDorUUIDWithRegion validates the schema is a UUID or the combination of a region and a UUID
+// e.g. "6ba7b810-9dad-11d1-80b4-00c04fd430c8" or "fr-par/6ba7b810-9dad-11d1-80b4-00c04fd430c8".
+// Zonal IDs are accepted as well, as their region can be inferred from the zone.
+func IsUUIDorUUIDWithRegion() schema.SchemaValidateDiagFunc {
+	return isUUIDorUUIDWithLocality(isZoneOrRegion, "region", "fr-par/")
+}
+
+func isUUIDorUUIDWithLocality(isValidLocality func(string) bool, localityKind string, example string) schema.SchemaValidateDiagFunc {
 	return func(value interface{}, path cty.Path) diag.Diagnostics {
-		return IsUUID()(locality.ExpandID(value), path)
+		rawID, isString := value.(string)
+		if !isString {
+			return diag.Diagnostics{diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "invalid UUID not a string",
+				AttributePath: path,
+			}}
+		}
+
+		loc, id, err := locality.ParseLocalizedID(rawID)
+		if err != nil {
+			// Not a localized ID, it has to be a raw UUID
+			if strings.Contains(rawID, "/") {
+				return diag.Diagnostics{diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "invalid ID: " + rawID,
+					AttributePath: path,
+					Detail:        fmt.Sprintf("expected a UUID or an ID with a %s like %s11111111-1111-1111-1111-111111111111", localityKind, example),
+				}}
+			}
+			return IsUUID()(rawID, path)
+		}
+
+		if !isValidLocality(loc) {
+			return diag.Diagnostics{diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("invalid %s %q in ID: %s", localityKind, loc, rawID),
+				AttributePath: path,
+				Detail:        fmt.Sprintf("expected a UUID or an ID with a %s like %s%s", localityKind, example, id),
+			}}
+		}
+
+		return IsUUID()(id, path)
+	}
+}
+
+func isZone(loc string) bool {
+	_, err := scw.ParseZone(loc)
+	return err == nil
+}
+
+func isZoneOrRegion(loc string) bool {
+	if isZone(loc) {
+		return true
 	}
+	_, err := scw.ParseRegion(loc)
+	return err == nil
 }
 
 // IsUUID validates the schema following the canonical UUID format
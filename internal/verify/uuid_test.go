@@ -48,3 +48,30 @@ func TestValidationUUIDWithLocalityWithInvalidUUIDReturnError(t *testing.T) {
 		assert.Len(t, diags, 1, uuid)
 	}
 }
+
+func TestValidationUUIDorUUIDWithZone(t *testing.T) {
+	for _, uuid := range []string{"fr-par-1/6ba7b810-9dad-11d1-80b4-00c04fd430c8", "6ba7b810-9dad-11d1-80b4-00c04fd430c8"} {
+		diags := verify.IsUUIDorUUIDWithZone()(uuid, cty.Path{})
+		assert.Empty(t, diags, uuid)
+	}
+
+	for _, uuid := range []string{"fr-par/6ba7b810-9dad-11d1-80b4-00c04fd430c8", "fr-par-1/wrong-uuid", "fr-par-1/6ba7b810-9dad-11d1-80b4-00c04fd430c8/resource"} {
+		diags := verify.IsUUIDorUUIDWithZone()(uuid, cty.Path{})
+		assert.Len(t, diags, 1, uuid)
+	}
+
+	diags := verify.IsUUIDorUUIDWithZone()("fr-par/6ba7b810-9dad-11d1-80b4-00c04fd430c8", cty.Path{})
+	assert.Equal(t, "expected a UUID or an ID with a zone like fr-par-1/6ba7b810-9dad-11d1-80b4-00c04fd430c8", diags[0].Detail)
+}
+
+func TestValidationUUIDorUUIDWithRegion(t *testing.T) {
+	for _, uuid := range []string{"fr-par/6ba7b810-9dad-11d1-80b4-00c04fd430c8", "fr-par-1/6ba7b810-9dad-11d1-80b4-00c04fd430c8", "6ba7b810-9dad-11d1-80b4-00c04fd430c8"} {
+		diags := verify.IsUUIDorUUIDWithRegion()(uuid, cty.Path{})
+		assert.Empty(t, diags, uuid)
+	}
+
+	for _, uuid := range []string{"fr-paris/6ba7b810-9dad-11d1-80b4-00c04fd430c8", "fr-par/wrong-uuid"} {
+		diags := verify.IsUUIDorUUIDWithRegion()(uuid, cty.Path{})
+		assert.Len(t, diags, 1, uuid)
+	}
+}
@@ -24,7 +24,7 @@ func main() {
 
 	providers := []func() tfprotov5.ProviderServer{
 		// Provider using terraform-plugin-sdk
-		provider.Provider(provider.DefaultConfig())().GRPCProvider,
+		provider.ProviderServer(provider.DefaultConfig()),
 	}
 
 	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)